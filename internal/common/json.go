@@ -1,8 +1,11 @@
 package common
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"regexp"
+	"strings"
 )
 
 // MarshalUnchecked return the JSON encoding of value
@@ -20,3 +23,42 @@ func ReplaceNull(s string) string {
 	re := regexp.MustCompile(`:<null>`)
 	return re.ReplaceAllString(s, ":null")
 }
+
+// NormalizeJSON returns the JSON encoding of s without insignificant whitespace and with object keys sorted,
+// so that semantically equal documents can be compared as string.
+func NormalizeJSON(s string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return "", err
+	}
+	if decoder.More() {
+		return "", fmt.Errorf("unexpected data after top-level JSON value")
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// JSONEquivalent reports whether two JSON documents are semantically equal
+func JSONEquivalent(a, b string) bool {
+	normalizedA, err := NormalizeJSON(a)
+	if err != nil {
+		return false
+	}
+
+	normalizedB, err := NormalizeJSON(b)
+	if err != nil {
+		return false
+	}
+
+	return normalizedA == normalizedB
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	cases := map[string]struct {
+		input    string
+		expected string
+		err      bool
+	}{
+		"whitespace": {
+			input:    "{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": []\n}",
+			expected: `{"Statement":[],"Version":"2012-10-17"}`,
+		},
+		"key order": {
+			input:    `{"b":1,"a":{"d":true,"c":null}}`,
+			expected: `{"a":{"c":null,"d":true},"b":1}`,
+		},
+		"keep number and html characters": {
+			input:    `{"n":1.50,"s":"<a&b>"}`,
+			expected: `{"n":1.50,"s":"<a&b>"}`,
+		},
+		"invalid": {
+			input: `{"a":`,
+			err:   true,
+		},
+		"trailing data": {
+			input: `{"a":1}{"b":2}`,
+			err:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		result, err := NormalizeJSON(tc.input)
+		if tc.err {
+			if err == nil {
+				t.Fatalf("%s: expected error, got %s", name, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		if result != tc.expected {
+			t.Fatalf("%s: Got:\n\n%s\n\nExpected:\n\n%s\n", name, result, tc.expected)
+		}
+	}
+}
+
+func TestJSONEquivalent(t *testing.T) {
+	if !JSONEquivalent(`{"a": [1, 2], "b": "c"}`, `{"b":"c","a":[1,2]}`) {
+		t.Fatal("expected documents to be equivalent")
+	}
+
+	if JSONEquivalent(`{"a": [1, 2]}`, `{"a": [2, 1]}`) {
+		t.Fatal("expected documents with different array order not to be equivalent")
+	}
+
+	if JSONEquivalent(`{"a": 1}`, `invalid`) {
+		t.Fatal("expected invalid document not to be equivalent")
+	}
+}
@@ -2,6 +2,9 @@ package conn
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
@@ -46,10 +49,43 @@ const DefaultStopTimeout = 5 * time.Minute
 
 var version = ""
 
+const publicApiGatewayDomain = ".apigw.ntruss.com"
+
+// EndpointServiceNames are the keys of provider `endpoints` block, each overrides base URL of the service API client.
+var EndpointServiceNames = []string{
+	"autoscaling",
+	"cdn",
+	"clouddb",
+	"loadbalancer",
+	"s3",
+	"server",
+	"sourcebuild",
+	"sourcecommit",
+	"sourcepipeline",
+	"vautoscaling",
+	"vcdss",
+	"vhadoop",
+	"vloadbalancer",
+	"vmongodb",
+	"vmssql",
+	"vmysql",
+	"vnas",
+	"vnks",
+	"vpc",
+	"vpostgresql",
+	"vredis",
+	"vserver",
+	"vses",
+	"vsourcedeploy",
+	"vsourcepipeline",
+}
+
 type Config struct {
 	AccessKey string
 	SecretKey string
 	Region    string
+	// Endpoints overrides base URL of API clients by service name in EndpointServiceNames.
+	Endpoints map[string]string
 }
 
 type NcloudAPIClient struct {
@@ -86,39 +122,92 @@ func (c *Config) Client(site, endpoint string) (*NcloudAPIClient, error) {
 		SecretKey: c.SecretKey,
 	}
 
+	if v := c.Endpoints["s3"]; v != "" {
+		endpoint = v
+	}
+
 	return &NcloudAPIClient{
-		Server:          server.NewAPIClient(server.NewConfiguration(apiKey)),
-		Autoscaling:     autoscaling.NewAPIClient(autoscaling.NewConfiguration(apiKey)),
-		Loadbalancer:    loadbalancer.NewAPIClient(loadbalancer.NewConfiguration(apiKey)),
-		Cdn:             cdn.NewAPIClient(cdn.NewConfiguration(apiKey)),
-		Clouddb:         clouddb.NewAPIClient(clouddb.NewConfiguration(apiKey)),
-		Vpc:             vpc.NewAPIClient(vpc.NewConfiguration(apiKey)),
-		Vserver:         vserver.NewAPIClient(vserver.NewConfiguration(apiKey)),
-		Vnas:            vnas.NewAPIClient(vnas.NewConfiguration(apiKey)),
-		Vautoscaling:    vautoscaling.NewAPIClient(vautoscaling.NewConfiguration(apiKey)),
-		Vloadbalancer:   vloadbalancer.NewAPIClient(vloadbalancer.NewConfiguration(apiKey)),
-		Vnks:            vnks.NewAPIClient(vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey)),
-		Sourcecommit:    sourcecommit.NewAPIClient(sourcecommit.NewConfiguration(c.Region, apiKey)),
-		Sourcebuild:     sourcebuild.NewAPIClient((sourcebuild.NewConfiguration(c.Region, apiKey))),
-		Sourcepipeline:  sourcepipeline.NewAPIClient(sourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vsourcedeploy:   vsourcedeploy.NewAPIClient(vsourcedeploy.NewConfiguration(c.Region, apiKey)),
-		Vsourcepipeline: vsourcepipeline.NewAPIClient(vsourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vses:            vses2.NewAPIClient(vses2.NewConfiguration(c.Region, apiKey)),
-		Vcdss:           vcdss.NewAPIClient(vcdss.NewConfiguration(c.Region, apiKey)),
-		Vmysql:          vmysql.NewAPIClient(vmysql.NewConfiguration(apiKey)),
-		Vmongodb:        vmongodb.NewAPIClient(vmongodb.NewConfiguration(apiKey)),
-		Vmssql:          vmssql.NewAPIClient(vmssql.NewConfiguration(apiKey)),
-		Vpostgresql:     vpostgresql.NewAPIClient(vpostgresql.NewConfiguration(apiKey)),
-		Vhadoop:         vhadoop.NewAPIClient(vhadoop.NewConfiguration(apiKey)),
-		Vredis:          vredis.NewAPIClient(vredis.NewConfiguration(apiKey)),
+		Server:          server.NewAPIClient(c.withEndpoint(site, "server", server.NewConfiguration(apiKey))),
+		Autoscaling:     autoscaling.NewAPIClient(c.withEndpoint(site, "autoscaling", autoscaling.NewConfiguration(apiKey))),
+		Loadbalancer:    loadbalancer.NewAPIClient(c.withEndpoint(site, "loadbalancer", loadbalancer.NewConfiguration(apiKey))),
+		Cdn:             cdn.NewAPIClient(c.withEndpoint(site, "cdn", cdn.NewConfiguration(apiKey))),
+		Clouddb:         clouddb.NewAPIClient(c.withEndpoint(site, "clouddb", clouddb.NewConfiguration(apiKey))),
+		Vpc:             vpc.NewAPIClient(c.withEndpoint(site, "vpc", vpc.NewConfiguration(apiKey))),
+		Vserver:         vserver.NewAPIClient(c.withEndpoint(site, "vserver", vserver.NewConfiguration(apiKey))),
+		Vnas:            vnas.NewAPIClient(c.withEndpoint(site, "vnas", vnas.NewConfiguration(apiKey))),
+		Vautoscaling:    vautoscaling.NewAPIClient(c.withEndpoint(site, "vautoscaling", vautoscaling.NewConfiguration(apiKey))),
+		Vloadbalancer:   vloadbalancer.NewAPIClient(c.withEndpoint(site, "vloadbalancer", vloadbalancer.NewConfiguration(apiKey))),
+		Vnks:            vnks.NewAPIClient(c.withEndpoint(site, "vnks", vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey))),
+		Sourcecommit:    sourcecommit.NewAPIClient(c.withEndpoint(site, "sourcecommit", sourcecommit.NewConfiguration(c.Region, apiKey))),
+		Sourcebuild:     sourcebuild.NewAPIClient(c.withEndpoint(site, "sourcebuild", sourcebuild.NewConfiguration(c.Region, apiKey))),
+		Sourcepipeline:  sourcepipeline.NewAPIClient(c.withEndpoint(site, "sourcepipeline", sourcepipeline.NewConfiguration(c.Region, apiKey))),
+		Vsourcedeploy:   vsourcedeploy.NewAPIClient(c.withEndpoint(site, "vsourcedeploy", vsourcedeploy.NewConfiguration(c.Region, apiKey))),
+		Vsourcepipeline: vsourcepipeline.NewAPIClient(c.withEndpoint(site, "vsourcepipeline", vsourcepipeline.NewConfiguration(c.Region, apiKey))),
+		Vses:            vses2.NewAPIClient(c.withEndpoint(site, "vses", vses2.NewConfiguration(c.Region, apiKey))),
+		Vcdss:           vcdss.NewAPIClient(c.withEndpoint(site, "vcdss", vcdss.NewConfiguration(c.Region, apiKey))),
+		Vmysql:          vmysql.NewAPIClient(c.withEndpoint(site, "vmysql", vmysql.NewConfiguration(apiKey))),
+		Vmongodb:        vmongodb.NewAPIClient(c.withEndpoint(site, "vmongodb", vmongodb.NewConfiguration(apiKey))),
+		Vmssql:          vmssql.NewAPIClient(c.withEndpoint(site, "vmssql", vmssql.NewConfiguration(apiKey))),
+		Vpostgresql:     vpostgresql.NewAPIClient(c.withEndpoint(site, "vpostgresql", vpostgresql.NewConfiguration(apiKey))),
+		Vhadoop:         vhadoop.NewAPIClient(c.withEndpoint(site, "vhadoop", vhadoop.NewConfiguration(apiKey))),
+		Vredis:          vredis.NewAPIClient(c.withEndpoint(site, "vredis", vredis.NewConfiguration(apiKey))),
 		ObjectStorage:   NewS3Client(c.Region, apiKey, site, endpoint),
 	}, nil
 }
 
+// withEndpoint points base path of the client configuration at the API gateway of the site,
+// and overrides it with the endpoint of the service, if configured.
+func (c *Config) withEndpoint(site, service string, cfg *ncloud.Configuration) *ncloud.Configuration {
+	cfg.BasePath = genBasePathWithSite(site, cfg.BasePath)
+
+	if v := c.Endpoints[service]; v != "" {
+		cfg.BasePath = strings.TrimSuffix(v, "/")
+	}
+
+	return cfg
+}
+
+// genBasePathWithSite replaces the public API gateway host of base path with the one of gov or fin site,
+// the same way SDK clients derive it from NCLOUD_API_GW. Base path already pointed elsewhere by NCLOUD_API_GW is kept.
+func genBasePathWithSite(site, basePath string) string {
+	u, err := url.Parse(basePath)
+	if err != nil {
+		return basePath
+	}
+
+	service, ok := strings.CutSuffix(u.Host, publicApiGatewayDomain)
+	if !ok {
+		return basePath
+	}
+
+	switch site {
+	case "gov":
+		u.Host = service + ".apigw.gov-ntruss.com"
+	case "fin":
+		switch service {
+		case "ncloud", "vpcsearchengine", "clouddatastreamingservice":
+			service = "fin-" + service
+		}
+		u.Host = service + ".apigw.fin-ntruss.com"
+	default:
+		return basePath
+	}
+
+	return u.String()
+}
+
 type ProviderConfig struct {
 	Site       string
 	SupportVPC bool
 	RegionCode string
 	RegionNo   string
 	Client     *NcloudAPIClient
+
+	// Regions and zones differ by site, so they are cached per provider configuration.
+	RegionCache sync.Map
+	ZoneCache   sync.Map
+
+	// Server specs and products available for a server image, to validate them at plan time
+	// without calling the API for every resource.
+	ServerProductCache sync.Map
 }
@@ -0,0 +1,73 @@
+package conn
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func TestConfigClientEndpoints(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"response": {"returnCode": "0"}}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: map[string]string{
+			"vpc":     server.URL + "/proxy/vpc/v2/",
+			"vserver": server.URL + "/proxy/vserver/v2",
+		},
+	}
+
+	client, err := config.Client("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Vpc.V2Api.GetVpcList(&vpc.GetVpcListRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Vserver.V2Api.GetRegionList(&vserver.GetRegionListRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"/proxy/vpc/v2/getVpcList", "/proxy/vserver/v2/getRegionList"}
+	if len(paths) != len(expected) {
+		t.Fatalf("Expected: %v, Actual: %v", expected, paths)
+	}
+	for i := range expected {
+		if paths[i] != expected[i] {
+			t.Fatalf("Expected: %v, Actual: %v", expected, paths)
+		}
+	}
+}
+
+func TestGenBasePathWithSite(t *testing.T) {
+	cases := []struct {
+		site     string
+		basePath string
+		expected string
+	}{
+		{"public", "https://ncloud.apigw.ntruss.com/vpc/v2", "https://ncloud.apigw.ntruss.com/vpc/v2"},
+		{"gov", "https://ncloud.apigw.ntruss.com/vpc/v2", "https://ncloud.apigw.gov-ntruss.com/vpc/v2"},
+		{"gov", "https://nks.apigw.ntruss.com/vnks/v2", "https://nks.apigw.gov-ntruss.com/vnks/v2"},
+		{"fin", "https://ncloud.apigw.ntruss.com/vserver/v2", "https://fin-ncloud.apigw.fin-ntruss.com/vserver/v2"},
+		{"fin", "https://sourcecommit.apigw.ntruss.com/api/v1", "https://sourcecommit.apigw.fin-ntruss.com/api/v1"},
+		{"fin", "https://vpcsearchengine.apigw.ntruss.com/api/v2", "https://fin-vpcsearchengine.apigw.fin-ntruss.com/api/v2"},
+		{"gov", "http://127.0.0.1:8080/vpc/v2", "http://127.0.0.1:8080/vpc/v2"},
+	}
+
+	for _, c := range cases {
+		if actual := genBasePathWithSite(c.site, c.basePath); actual != c.expected {
+			t.Errorf("site %s, base path %s. Expected: %s, Actual: %s", c.site, c.basePath, c.expected, actual)
+		}
+	}
+}
@@ -2,8 +2,6 @@ package conn
 
 import (
 	"fmt"
-	"os"
-	"sync"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
@@ -16,11 +14,9 @@ type Region struct {
 	RegionName *string `json:"regionName,omitempty"`
 }
 
-var regionCacheByCode = sync.Map{}
-
-func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
+func ParseRegionNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		regionNo := GetRegionNoByCode(regionCode.(string))
+		regionNo := GetRegionNoByCode(config, regionCode.(string))
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
 		}
@@ -28,8 +24,8 @@ func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		regionNo := GetRegionNoByCode(regionCode)
+	if regionCode := config.RegionCode; regionCode != "" {
+		regionNo := GetRegionNoByCode(config, regionCode)
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
 		}
@@ -39,18 +35,18 @@ func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	return nil, nil
 }
 
-func GetRegionNoByCode(code string) *string {
-	if region, ok := regionCacheByCode.Load(code); ok {
+func GetRegionNoByCode(config *ProviderConfig, code string) *string {
+	if region, ok := config.RegionCache.Load(code); ok {
 		return region.(Region).RegionNo
 	}
 	return nil
 }
 
-func SetRegionCache(client *NcloudAPIClient) error {
+func SetRegionCache(config *ProviderConfig) error {
 	var regionList []*Region
 	var err error
 
-	regionList, err = getVpcRegionList(client)
+	regionList, err = getVpcRegionList(config.Client)
 	if err != nil {
 		return err
 	}
@@ -61,7 +57,7 @@ func SetRegionCache(client *NcloudAPIClient) error {
 			RegionName: r.RegionName,
 		}
 
-		regionCacheByCode.Store(*region.RegionCode, region)
+		config.RegionCache.Store(*region.RegionCode, region)
 	}
 
 	return nil
@@ -84,7 +80,7 @@ func getVpcRegionList(client *NcloudAPIClient) ([]*Region, error) {
 	return regionList, nil
 }
 
-func IsValidRegionCode(code string) bool {
-	_, ok := regionCacheByCode.Load(code)
+func IsValidRegionCode(config *ProviderConfig, code string) bool {
+	_, ok := config.RegionCache.Load(code)
 	return ok
 }
@@ -2,6 +2,7 @@ package fwprovider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/hadoop"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
@@ -9,10 +10,12 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/postgresql"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mongodb"
@@ -61,6 +64,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Support VPC platform",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"endpoints": endpointsBlock(),
+		},
+	}
+}
+
+func endpointsBlock() schema.Block {
+	attributes := map[string]schema.Attribute{}
+
+	for _, service := range conn.EndpointServiceNames {
+		attributes[service] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Use this to override the default base URL of %s API", service),
+		}
+	}
+
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+		Description: "Custom base URL of service APIs",
 	}
 }
 
@@ -84,6 +111,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, server.NewInitScriptDataSource)
 	dataSources = append(dataSources, server.NewLoginKeyDataSource)
 	dataSources = append(dataSources, server.NewServerImageNumbersDataSource)
+	dataSources = append(dataSources, server.NewServerImageSharingDataSource)
 	dataSources = append(dataSources, server.NewServerSpecsDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlImageProductsDataSource)
@@ -114,6 +142,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, loadbalancer.NewLoadBalancerDataSource)
 	dataSources = append(dataSources, objectstorage.NewBucketDataSource)
 	dataSources = append(dataSources, objectstorage.NewObjectDataSource)
+	dataSources = append(dataSources, objectstorage.NewBucketVersioningDataSource)
 
 	if err := errs.ErrorOrNil(); err != nil {
 		tflog.Warn(ctx, "registering resources", map[string]interface{}{
@@ -134,6 +163,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, vpc.NewVpcPeeringResource)
 	resources = append(resources, server.NewLoginKeyResource)
 	resources = append(resources, server.NewInitScriptResource)
+	resources = append(resources, server.NewServerImageResource)
+	resources = append(resources, server.NewServerImageSharingResource)
+	resources = append(resources, server.NewPublicIpAssociationResource)
 	resources = append(resources, mysql.NewMysqlResource)
 	resources = append(resources, mysql.NewMysqlUsersResource)
 	resources = append(resources, mysql.NewMysqlRecoveryResource)
@@ -150,11 +182,17 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, postgresql.NewPostgresqlDatabasesResource)
 	resources = append(resources, postgresql.NewPostgresqlUsersResource)
 	resources = append(resources, loadbalancer.NewLbResource)
+	resources = append(resources, loadbalancer.NewLbTargetGroupResource)
 	resources = append(resources, objectstorage.NewBucketResource)
 	resources = append(resources, objectstorage.NewObjectResource)
 	resources = append(resources, objectstorage.NewObjectACLResource)
 	resources = append(resources, objectstorage.NewBucketACLResource)
 	resources = append(resources, objectstorage.NewObjectCopyResource)
+	resources = append(resources, objectstorage.NewBucketVersioningResource)
+	resources = append(resources, objectstorage.NewBucketLifecycleConfigurationResource)
+	resources = append(resources, objectstorage.NewBucketCORSConfigurationResource)
+	resources = append(resources, objectstorage.NewBucketPolicyResource)
+	resources = append(resources, objectstorage.NewBucketWebsiteConfigurationResource)
 
 	if err := errs.ErrorOrNil(); err != nil {
 		tflog.Warn(ctx, "registering resources", map[string]interface{}{
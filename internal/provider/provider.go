@@ -42,6 +42,8 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_cdss_os_images":                          cdss.DataSourceNcloudCDSSOsImages(),
 		"ncloud_launch_configuration":                    autoscaling.DataSourceNcloudLaunchConfiguration(),
 		"ncloud_lb_listener":                             loadbalancer.DataSourceNcloudLbListener(),
+		"ncloud_lb_listener_certificates":                loadbalancer.DataSourceNcloudLbListenerCertificates(),
+		"ncloud_lb_listener_rules":                       loadbalancer.DataSourceNcloudLbListenerRules(),
 		"ncloud_lb_target_group":                         loadbalancer.DataSourceNcloudLbTargetGroup(),
 		"ncloud_member_server_image":                     server.DataSourceNcloudMemberServerImage(),
 		"ncloud_member_server_images":                    server.DataSourceNcloudMemberServerImages(),
@@ -109,7 +111,7 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_launch_configuration":                autoscaling.ResourceNcloudLaunchConfiguration(),
 		"ncloud_lb_listener":                         loadbalancer.ResourceNcloudLbListener(),
 		"ncloud_lb_target_group_attachment":          loadbalancer.ResourceNcloudLbTargetGroupAttachment(),
-		"ncloud_lb_target_group":                     loadbalancer.ResourceNcloudLbTargetGroup(),
+		"ncloud_lb_target_group_target":              loadbalancer.ResourceNcloudLbTargetGroupTarget(),
 		"ncloud_nas_volume":                          nasvolume.ResourceNcloudNasVolume(),
 		"ncloud_network_acl":                         vpc.ResourceNcloudNetworkACL(),
 		"ncloud_network_acl_deny_allow_group":        vpc.ResourceNcloudNetworkACLDenyAllowGroup(),
@@ -167,6 +169,29 @@ func SchemaMap() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Support VPC platform",
 		},
+		"endpoints": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        endpointsSchema(),
+			Description: "Custom base URL of service APIs",
+		},
+	}
+}
+
+func endpointsSchema() *schema.Resource {
+	endpoints := map[string]*schema.Schema{}
+
+	for _, service := range conn.EndpointServiceNames {
+		endpoints[service] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Use this to override the default base URL of %s API", service),
+		}
+	}
+
+	return &schema.Resource{
+		Schema: endpoints,
 	}
 }
 
@@ -184,13 +209,6 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	// Set site
 	if site, ok := getOrFromEnv(d, "site", "NCLOUD_SITE"); ok {
 		providerConfig.Site = site.(string)
-
-		switch site {
-		case "gov":
-			os.Setenv("NCLOUD_API_GW", "https://ncloud.apigw.gov-ntruss.com")
-		case "fin":
-			os.Setenv("NCLOUD_API_GW", "https://fin-ncloud.apigw.fin-ntruss.com")
-		}
 	}
 
 	accessKey, ok := getOrFromEnv(d, "access_key", "NCLOUD_ACCESS_KEY")
@@ -211,6 +229,7 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		AccessKey: accessKey.(string),
 		SecretKey: secretKey.(string),
 		Region:    region.(string),
+		Endpoints: expandEndpoints(d.Get("endpoints").([]interface{})),
 	}
 
 	// Set endpoint (only for debugging)
@@ -223,12 +242,11 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	}
 
 	// Set region
-	if err := conn.SetRegionCache(providerConfig.Client); err != nil {
+	if err := conn.SetRegionCache(&providerConfig); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	if conn.IsValidRegionCode(region.(string)) {
-		os.Setenv("NCLOUD_REGION", region.(string))
+	if conn.IsValidRegionCode(&providerConfig, region.(string)) {
 		providerConfig.RegionCode = region.(string)
 	} else {
 		return nil, []diag.Diagnostic{
@@ -242,6 +260,25 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	return &providerConfig, nil
 }
 
+func expandEndpoints(l []interface{}) map[string]string {
+	endpoints := map[string]string{}
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, service := range conn.EndpointServiceNames {
+			if endpoint, ok := m[service].(string); ok && endpoint != "" {
+				endpoints[service] = endpoint
+			}
+		}
+	}
+
+	return endpoints
+}
+
 func getOrFromEnv(d *schema.ResourceData, name, env string) (any, bool) {
 	if v, ok := d.GetOk(name); ok {
 		return v, true
@@ -1,6 +1,8 @@
 package autoscaling
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -8,6 +10,7 @@ import (
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
 )
 
 func ResourceNcloudLaunchConfiguration() *schema.Resource {
@@ -18,6 +21,7 @@ func ResourceNcloudLaunchConfiguration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateLaunchConfigurationServerProduct,
 		Schema: map[string]*schema.Schema{
 			"launch_configuration_no": {
 				Type:     schema.TypeString,
@@ -70,6 +74,22 @@ func ResourceNcloudLaunchConfiguration() *schema.Resource {
 	}
 }
 
+// validateLaunchConfigurationServerProduct rejects server product unavailable for the server image at plan time.
+func validateLaunchConfigurationServerProduct(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("server_product_code") || !d.NewValueKnown("server_image_product_code") || !d.NewValueKnown("member_server_image_no") {
+		return nil
+	}
+
+	productCode := d.Get("server_product_code").(string)
+	imageProductCode := d.Get("server_image_product_code").(string)
+	memberServerImageNo := d.Get("member_server_image_no").(string)
+	if productCode == "" || (imageProductCode == "" && memberServerImageNo == "") {
+		return nil
+	}
+
+	return server.ValidateServerProductCode(meta.(*conn.ProviderConfig), imageProductCode, memberServerImageNo, "", productCode)
+}
+
 func resourceNcloudLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 	id, err := createLaunchConfiguration(d, config)
@@ -2,6 +2,7 @@ package autoscaling_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -65,6 +66,36 @@ func TestAccResourceNcloudLaunchConfiguration_vpc_disappears(t *testing.T) {
 	})
 }
 
+func TestResourceNcloudLaunchConfiguration_fixture_productValidation(t *testing.T) {
+	gw := NewFakeAPIGateway(t, "launch_configuration_product_validation")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "ncloud_launch_configuration" "valid" {
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code       = "SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002"
+}
+
+resource "ncloud_launch_configuration" "invalid" {
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code       = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`server_product_code\s+"SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"\s+is\s+not\s+available\s+for\s+server\s+image\s+"SW.VSVR.OS.LNX64.ROCKY.0810.B050".\s+Valid\s+server\s+products:\s+SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002,\s+SVR.VSVR.HICPU.C004.M008.NET.SSD.B050.G002`),
+			},
+		},
+	})
+
+	// Product list of the same image is looked up once per provider configuration.
+	if n := gw.Requests("/vserver/v2/getServerProductList"); n != 1 {
+		t.Errorf("expected 1 getServerProductList request, got %d", n)
+	}
+}
+
 func testAccCheckLaunchConfigurationExists(n string, l *autoscaling.LaunchConfiguration, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,124 @@
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// DataSourceNcloudLbListenerCertificates reads the SSL certificates used by a load balancer listener.
+// Certificates themselves are registered in Certificate Manager, which the API client does not support.
+func DataSourceNcloudLbListenerCertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNcloudLbListenerCertificatesRead,
+		Schema: map[string]*schema.Schema{
+			"listener_no": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": DataSourceFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssl_certificate_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ssl_certificate_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNcloudLbListenerCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	certificateList, err := getVpcLoadBalancerListenerCertificateList(config, d.Get("listener_no").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	certificateListMap := ConvertToArrayMap(certificateList)
+	if f, ok := d.GetOk("filter"); ok {
+		certificateListMap = ApplyFilters(f.(*schema.Set), certificateListMap, DataSourceNcloudLbListenerCertificates().Schema["certificates"].Elem.(*schema.Resource).Schema)
+	}
+
+	ids := make([]string, 0, len(certificateListMap))
+	for _, c := range certificateListMap {
+		ids = append(ids, c["ssl_certificate_no"].(string))
+	}
+
+	d.SetId(DataResourceIdHash(append([]string{d.Get("listener_no").(string)}, ids...)))
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("certificates", certificateListMap); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func getVpcLoadBalancerListenerCertificateList(config *conn.ProviderConfig, listenerNo string) ([]*LoadBalancerListenerCertificate, error) {
+	reqParams := &vloadbalancer.GetLoadBalancerListenerCertificateListRequest{
+		RegionCode:             &config.RegionCode,
+		LoadBalancerListenerNo: ncloud.String(listenerNo),
+	}
+
+	LogCommonRequest("getLoadBalancerListenerCertificateList", reqParams)
+	resp, err := config.Client.Vloadbalancer.V2Api.GetLoadBalancerListenerCertificateList(reqParams)
+	if err != nil {
+		LogErrorResponse("getLoadBalancerListenerCertificateList", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getLoadBalancerListenerCertificateList", resp)
+
+	certificateList := make([]*LoadBalancerListenerCertificate, 0, len(resp.LoadBalancerListenerCertificateList))
+	for _, c := range resp.LoadBalancerListenerCertificateList {
+		certificateList = append(certificateList, &LoadBalancerListenerCertificate{
+			SslCertificateNo:   c.SslCertificateNo,
+			SslCertificateName: c.SslCertificateName,
+			DomainAddress:      c.DomainAddress,
+			ValidEndDate:       c.ValidEndDate,
+			IsDefault:          c.IsDefault,
+			StatusName:         c.StatusName,
+		})
+	}
+
+	return certificateList, nil
+}
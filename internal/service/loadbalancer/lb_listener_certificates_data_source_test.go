@@ -0,0 +1,86 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudLbListenerCertificates_basic(t *testing.T) {
+	lbName := fmt.Sprintf("terraform-testacc-lb-%s", acctest.RandString(5))
+	dataName := "data.ncloud_lb_listener_certificates.test"
+	// Certificate must be registered in Certificate Manager beforehand.
+	certificateNo := os.Getenv("NCLOUD_SSL_CERTIFICATE_NO")
+	if certificateNo == "" {
+		t.Skip("NCLOUD_SSL_CERTIFICATE_NO must be set for load balancer listener certificates acceptance test")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudLbListenerCertificatesConfig(lbName, certificateNo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttr(dataName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "certificates.0.ssl_certificate_no", certificateNo),
+					resource.TestCheckResourceAttrSet(dataName, "certificates.0.valid_end_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceNcloudLbListenerCertificates_fixture_basic(t *testing.T) {
+	NewFakeAPIGateway(t, "lb_listener_certificates_basic")
+	dataName := "data.ncloud_lb_listener_certificates.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "ncloud_lb_listener_certificates" "test" {
+	listener_no = "30001"
+
+	filter {
+		name   = "domain"
+		values = ["api.example.com"]
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "certificates.0.ssl_certificate_no", "6001"),
+					resource.TestCheckResourceAttr(dataName, "certificates.0.ssl_certificate_name", "api-cert"),
+					resource.TestCheckResourceAttr(dataName, "certificates.0.domain", "api.example.com"),
+					resource.TestCheckResourceAttr(dataName, "certificates.0.valid_end_date", "2027-03-31T23:59:59+0900"),
+					resource.TestCheckResourceAttr(dataName, "certificates.0.is_default", "true"),
+					resource.TestCheckResourceAttr(dataName, "certificates.0.status", "Normal"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudLbListenerCertificatesConfig(name, certificateNo string) string {
+	return testAccResourceNcloudLbConfig(name) + fmt.Sprintf(`
+resource "ncloud_lb_listener" "test" {
+	load_balancer_no   = ncloud_lb.test.load_balancer_no
+	protocol           = "HTTPS"
+	port               = 443
+	target_group_no    = ncloud_lb_target_group.test.target_group_no
+	ssl_certificate_no = "%s"
+}
+
+data "ncloud_lb_listener_certificates" "test" {
+	listener_no = ncloud_lb_listener.test.listener_no
+}
+`, certificateNo)
+}
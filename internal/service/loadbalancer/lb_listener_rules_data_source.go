@@ -0,0 +1,236 @@
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// DataSourceNcloudLbListenerRules reads the routing rules of an application load balancer listener.
+// Rules are read only, because the API does not provide actions to create, change or delete them.
+func DataSourceNcloudLbListenerRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNcloudLbListenerRulesRead,
+		Schema: map[string]*schema.Schema{
+			"listener_no": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": DataSourceFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"listener_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"host_header_values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"path_pattern_values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_group": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"target_group_no": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"weight": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"use_sticky_session": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"redirect": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"protocol": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"port": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"host": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"path": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"query": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"status_code": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNcloudLbListenerRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	ruleList, err := getVpcLoadBalancerRuleList(config, d.Get("listener_no").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleListMap := ConvertToArrayMap(ruleList)
+	if f, ok := d.GetOk("filter"); ok {
+		ruleListMap = ApplyFilters(f.(*schema.Set), ruleListMap, DataSourceNcloudLbListenerRules().Schema["rules"].Elem.(*schema.Resource).Schema)
+	}
+
+	ids := make([]string, 0, len(ruleListMap))
+	for _, r := range ruleListMap {
+		ids = append(ids, r["rule_no"].(string))
+	}
+
+	d.SetId(DataResourceIdHash(append([]string{d.Get("listener_no").(string)}, ids...)))
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("rules", ruleListMap); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func getVpcLoadBalancerRuleList(config *conn.ProviderConfig, listenerNo string) ([]*LoadBalancerRule, error) {
+	reqParams := &vloadbalancer.GetLoadBalancerRuleListRequest{
+		RegionCode:             &config.RegionCode,
+		LoadBalancerListenerNo: ncloud.String(listenerNo),
+	}
+
+	LogCommonRequest("getLoadBalancerRuleList", reqParams)
+	resp, err := config.Client.Vloadbalancer.V2Api.GetLoadBalancerRuleList(reqParams)
+	if err != nil {
+		LogErrorResponse("getLoadBalancerRuleList", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getLoadBalancerRuleList", resp)
+
+	ruleList := make([]*LoadBalancerRule, 0, len(resp.LoadBalancerRuleList))
+	for _, r := range resp.LoadBalancerRuleList {
+		rule := &LoadBalancerRule{
+			LoadBalancerRuleNo:     r.LoadBalancerRuleNo,
+			LoadBalancerListenerNo: r.LoadBalancerListenerNo,
+			Priority:               r.Priority,
+		}
+
+		for _, c := range r.LoadBalancerRuleConditionList {
+			condition := &LoadBalancerRuleCondition{
+				RuleConditionType: GetCodePtrByCommonCode(c.RuleConditionType),
+			}
+			if c.HostHeaderCondition != nil {
+				condition.HostHeaderList = c.HostHeaderCondition.HostHeaderList
+			}
+			if c.PathPatternCondition != nil {
+				condition.PathPatternList = c.PathPatternCondition.PathPatternList
+			}
+			rule.ConditionList = append(rule.ConditionList, condition)
+		}
+
+		for _, a := range r.LoadBalancerRuleActionList {
+			action := &LoadBalancerRuleAction{
+				RuleActionType: GetCodePtrByCommonCode(a.RuleActionType),
+			}
+			if a.TargetGroupAction != nil {
+				action.UseStickySession = a.TargetGroupAction.UseStickySession
+				for _, w := range a.TargetGroupAction.TargetGroupWeightList {
+					action.TargetGroupList = append(action.TargetGroupList, &TargetGroupWeight{
+						TargetGroupNo: w.TargetGroupNo,
+						Weight:        w.Weight,
+					})
+				}
+			}
+			if redirect := a.RedirectionAction; redirect != nil {
+				action.RedirectionAction = []*RedirectionAction{{
+					Protocol:   redirect.Protocol,
+					Port:       redirect.Port,
+					Host:       redirect.Host,
+					Path:       redirect.Path,
+					Query:      redirect.Query,
+					StatusCode: redirect.StatusCode,
+				}}
+			}
+			rule.ActionList = append(rule.ActionList, action)
+		}
+
+		ruleList = append(ruleList, rule)
+	}
+
+	return ruleList, nil
+}
@@ -0,0 +1,92 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudLbListenerRules_basic(t *testing.T) {
+	lbName := fmt.Sprintf("terraform-testacc-lb-%s", acctest.RandString(5))
+	dataName := "data.ncloud_lb_listener_rules.test"
+	resourceName := "ncloud_lb_listener.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudLbListenerRulesConfig(lbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttrPair(dataName, "ids.#", resourceName, "rule_no_list.#"),
+					resource.TestCheckResourceAttrPair(dataName, "rules.0.listener_no", resourceName, "listener_no"),
+					resource.TestCheckResourceAttrPair(dataName, "rules.0.action.0.target_group.0.target_group_no", resourceName, "target_group_no"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceNcloudLbListenerRules_fixture_basic(t *testing.T) {
+	NewFakeAPIGateway(t, "lb_listener_rules_basic")
+	dataName := "data.ncloud_lb_listener_rules.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "ncloud_lb_listener_rules" "test" {
+	listener_no = "30001"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.rule_no", "40001"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.priority", "1"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.condition.0.type", "HOST_HEADER"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.condition.0.host_header_values.0", "api.example.com"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.condition.1.type", "PATH_PATTERN"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.condition.1.path_pattern_values.0", "/v1/*"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.action.0.type", "TARGET_GROUP"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.action.0.target_group.0.target_group_no", "50001"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.action.0.target_group.0.weight", "100"),
+					resource.TestCheckResourceAttr(dataName, "rules.0.action.0.use_sticky_session", "false"),
+					resource.TestCheckResourceAttr(dataName, "rules.1.rule_no", "40002"),
+					resource.TestCheckResourceAttr(dataName, "rules.1.action.0.type", "REDIRECTION"),
+					resource.TestCheckResourceAttr(dataName, "rules.1.action.0.redirect.0.protocol", "HTTPS"),
+					resource.TestCheckResourceAttr(dataName, "rules.1.action.0.redirect.0.port", "443"),
+					resource.TestCheckResourceAttr(dataName, "rules.1.action.0.redirect.0.status_code", "301"),
+				),
+			},
+			{
+				Config: `
+data "ncloud_lb_listener_rules" "test" {
+	listener_no = "30001"
+
+	filter {
+		name   = "priority"
+		values = ["2"]
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "ids.0", "40002"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudLbListenerRulesConfig(name string) string {
+	return testAccResourceNcloudLbListenerConfig(name) + `
+data "ncloud_lb_listener_rules" "test" {
+	listener_no = ncloud_lb_listener.test.listener_no
+}
+`
+}
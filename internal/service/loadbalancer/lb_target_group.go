@@ -6,292 +6,553 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 )
 
-func ResourceNcloudLbTargetGroup() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceNcloudTargetGroupCreate,
-		ReadContext:   resourceNcloudTargetGroupRead,
-		UpdateContext: resourceNcloudTargetGroupUpdate,
-		DeleteContext: resourceNcloudTargetGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"target_group_no": {
-				Type:     schema.TypeString,
+var (
+	_ resource.Resource                   = &lbTargetGroupResource{}
+	_ resource.ResourceWithConfigure      = &lbTargetGroupResource{}
+	_ resource.ResourceWithImportState    = &lbTargetGroupResource{}
+	_ resource.ResourceWithValidateConfig = &lbTargetGroupResource{}
+)
+
+func NewLbTargetGroupResource() resource.Resource {
+	return &lbTargetGroupResource{}
+}
+
+type lbTargetGroupResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *lbTargetGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("target_group_no"), req, resp)
+}
+
+func (r *lbTargetGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lb_target_group"
+}
+
+func (r *lbTargetGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"target_group_no": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"name": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(3, 30)),
-			},
-			"port": {
-				Type:             schema.TypeInt,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65534)),
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 30),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"protocol": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TCP", "PROXY_TCP", "HTTP", "HTTPS", "UDP"}, false)),
+			"port": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65534),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
-			"description": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("TCP", "PROXY_TCP", "HTTP", "HTTPS", "UDP"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"health_check": {
-				Type:     schema.TypeList,
+			"description": schema.StringAttribute{
 				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cycle": {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							Default:          30,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(5, 300)),
-						},
-						"down_threshold": {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							Default:          2,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(2, 10)),
-						},
-						"up_threshold": {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							Default:          2,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(2, 10)),
-						},
-						"http_method": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"HEAD", "GET"}, false)),
-						},
-						"port": {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							Default:          80,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65534)),
-						},
-						"protocol": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ForceNew:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TCP", "HTTP", "HTTPS"}, false)),
-						},
-						"url_path": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-					},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
 				},
 			},
-			"target_no_list": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"target_no_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"target_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"VSVR"}, false)),
+			"target_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("VSVR"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"vpc_no": {
-				Type:     schema.TypeString,
+			"vpc_no": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"use_sticky_session": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"use_sticky_session": {
-				Type:     schema.TypeBool,
+			"use_proxy_protocol": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"use_proxy_protocol": {
-				Type:     schema.TypeBool,
+			"algorithm_type": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("RR", "SIPHS", "LC", "MH"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"algorithm_type": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"RR", "SIPHS", "LC", "MH"}, false)),
+			"load_balancer_instance_no": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"load_balancer_instance_no": {
-				Type:     schema.TypeString,
+			"health_check": schema.ListNestedAttribute{
+				Optional: true,
 				Computed: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cycle": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int32default.StaticInt32(30),
+							Validators: []validator.Int32{
+								int32validator.Between(5, 300),
+							},
+						},
+						"down_threshold": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int32default.StaticInt32(2),
+							Validators: []validator.Int32{
+								int32validator.Between(2, 10),
+							},
+						},
+						"up_threshold": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int32default.StaticInt32(2),
+							Validators: []validator.Int32{
+								int32validator.Between(2, 10),
+							},
+						},
+						"http_method": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("HEAD", "GET"),
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"port": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int32default.StaticInt32(80),
+							Validators: []validator.Int32{
+								int32validator.Between(1, 65534),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("TCP", "HTTP", "HTTPS"),
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"url_path": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
-func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
+// ValidateConfig rejects combinations of protocol, algorithm and health check the API does not accept,
+// so that they fail at plan time instead of halfway through apply.
+func (r *lbTargetGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config lbTargetGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Protocol.IsUnknown() || config.Protocol.IsNull() {
+		return
+	}
+	protocol := config.Protocol.ValueString()
+
+	if isKnownValue(config.AlgorithmType) {
+		if err := validateAlgorithmTypeByTargetGroupProtocol(config.AlgorithmType.ValueString(), protocol); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("algorithm_type"), "Invalid algorithm_type", err.Error())
+		}
+	}
+
+	if isKnownValue(config.UseStickySession) && config.UseStickySession.ValueBool() && !ContainsInStringList(protocol, []string{"HTTP", "HTTPS", "TCP"}) {
+		resp.Diagnostics.AddAttributeError(path.Root("use_sticky_session"), "Invalid use_sticky_session",
+			fmt.Sprintf("use_sticky_session is only supported when target group protocol is HTTP, HTTPS or TCP, not %s.", protocol))
+	}
+
+	if isKnownValue(config.UseProxyProtocol) && config.UseProxyProtocol.ValueBool() && protocol != "PROXY_TCP" {
+		resp.Diagnostics.AddAttributeError(path.Root("use_proxy_protocol"), "Invalid use_proxy_protocol",
+			fmt.Sprintf("use_proxy_protocol is only supported when target group protocol is PROXY_TCP, not %s.", protocol))
+	}
+
+	if config.HealthCheck.IsUnknown() || len(config.HealthCheck.Elements()) == 0 {
+		return
+	}
+
+	var healthChecks []lbTargetGroupHealthCheckModel
+	resp.Diagnostics.Append(config.HealthCheck.ElementsAs(ctx, &healthChecks, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	healthCheck := healthChecks[0]
+
+	if !isKnownValue(healthCheck.Protocol) {
+		return
+	}
+	healthCheckProtocol := healthCheck.Protocol.ValueString()
+
+	if err := validateHealthCheckProtocolByTargetGroupProtocol(protocol, healthCheckProtocol); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("health_check").AtListIndex(0).AtName("protocol"), "Invalid health_check protocol", err.Error())
+	}
+
+	if healthCheck.isHttp() {
+		if healthCheck.HttpMethod.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("health_check").AtListIndex(0).AtName("http_method"), "Missing health_check http_method",
+				"http_method is required if the health check protocol type is HTTP or HTTPS.")
+		}
+		return
+	}
+
+	for _, name := range []string{"http_method", "url_path"} {
+		var v types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("health_check").AtListIndex(0).AtName(name), &v)...)
+		if isKnownValue(v) {
+			resp.Diagnostics.AddAttributeError(path.Root("health_check").AtListIndex(0).AtName(name), fmt.Sprintf("Invalid health_check %s", name),
+				fmt.Sprintf("%s is only supported if the health check protocol type is HTTP or HTTPS.", name))
+		}
+	}
+}
+
+func (r *lbTargetGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *lbTargetGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lbTargetGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	reqParams := &vloadbalancer.CreateTargetGroupRequest{
-		RegionCode: &config.RegionCode,
+		RegionCode: &r.config.RegionCode,
 		// Optional
-		TargetGroupPort:        Int32PtrOrNil(d.GetOk("port")),
-		TargetGroupDescription: StringPtrOrNil(d.GetOk("description")),
-		TargetGroupName:        StringPtrOrNil(d.GetOk("name")),
+		TargetGroupPort:        knownInt32Pointer(plan.Port),
+		TargetGroupDescription: knownStringPointer(plan.Description),
+		TargetGroupName:        knownStringPointer(plan.Name),
+		TargetTypeCode:         knownStringPointer(plan.TargetType),
 		// Required
-		TargetTypeCode:              ncloud.String(d.Get("target_type").(string)),
-		VpcNo:                       ncloud.String(d.Get("vpc_no").(string)),
-		TargetGroupProtocolTypeCode: ncloud.String(d.Get("protocol").(string)),
+		VpcNo:                       plan.VpcNo.ValueStringPointer(),
+		TargetGroupProtocolTypeCode: plan.Protocol.ValueStringPointer(),
 	}
 
-	if err := validateVpcTargetGroupVpc(config, *reqParams.VpcNo); err != nil {
-		return diag.FromErr(err)
+	if err := validateVpcTargetGroupVpc(r.config, plan.VpcNo.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
 	}
 
-	if err := validateVpcTargetGroupDuplicateName(config, ncloud.StringValue(reqParams.TargetGroupName)); err != nil {
-		return diag.FromErr(err)
+	if err := validateVpcTargetGroupDuplicateName(r.config, ncloud.StringValue(reqParams.TargetGroupName)); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
 	}
 
-	if healthChecks, ok := d.GetOk("health_check"); ok {
-		healthCheck := healthChecks.([]interface{})[0].(map[string]interface{})
-
-		reqParams.HealthCheckCycle = ncloud.Int32(int32(healthCheck["cycle"].(int)))
-		reqParams.HealthCheckDownThreshold = ncloud.Int32(int32(healthCheck["down_threshold"].(int)))
-		reqParams.HealthCheckUpThreshold = ncloud.Int32(int32(healthCheck["up_threshold"].(int)))
-		reqParams.HealthCheckPort = ncloud.Int32(int32(healthCheck["port"].(int)))
+	healthCheck, diags := plan.healthCheck(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		// Required
-		reqParams.HealthCheckProtocolTypeCode = ncloud.String(healthCheck["protocol"].(string))
-		if err := validateHealthCheckProtocolByTargetGroupProtocol(*reqParams.TargetGroupProtocolTypeCode, *reqParams.HealthCheckProtocolTypeCode); err != nil {
-			return diag.FromErr(err)
+	if healthCheck != nil {
+		reqParams.HealthCheckProtocolTypeCode = healthCheck.Protocol.ValueStringPointer()
+		reqParams.HealthCheckCycle = healthCheck.Cycle.ValueInt32Pointer()
+		reqParams.HealthCheckDownThreshold = healthCheck.DownThreshold.ValueInt32Pointer()
+		reqParams.HealthCheckUpThreshold = healthCheck.UpThreshold.ValueInt32Pointer()
+		reqParams.HealthCheckPort = healthCheck.Port.ValueInt32Pointer()
+		if healthCheck.isHttp() {
+			reqParams.HealthCheckUrlPath = knownStringPointer(healthCheck.UrlPath)
+			reqParams.HealthCheckHttpMethodTypeCode = knownStringPointer(healthCheck.HttpMethod)
 		}
+	}
 
-		if *reqParams.HealthCheckProtocolTypeCode == "HTTP" || *reqParams.HealthCheckProtocolTypeCode == "HTTPS" {
-			reqParams.HealthCheckUrlPath = ncloud.String(healthCheck["url_path"].(string))
-			if healthCheck["http_method"] == "" {
-				return diag.FromErr(fmt.Errorf("http_method is required if the health check protocol type is HTTP or HTTPS."))
-			}
-			reqParams.HealthCheckHttpMethodTypeCode = ncloud.String(healthCheck["http_method"].(string))
+	LogCommonRequest("createTargetGroup", reqParams)
+	response, err := r.config.Client.Vloadbalancer.V2Api.CreateTargetGroup(reqParams)
+	if err != nil {
+		LogErrorResponse("createTargetGroup", err, reqParams)
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	LogResponse("createTargetGroup", response)
+
+	id := ncloud.StringValue(response.TargetGroupList[0].TargetGroupNo)
+
+	// Target group is saved before the follow-up changes, so that it is not lost when they fail.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_group_no"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Algorithm and session options are not accepted on creation.
+	if isKnownValue(plan.AlgorithmType) || isKnownValue(plan.UseStickySession) || isKnownValue(plan.UseProxyProtocol) {
+		if err := changeTargetGroupConfiguration(r.config, id, &plan); err != nil {
+			resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+			return
 		}
 	}
 
-	LogCommonRequest("resourceNcloudTargetGroupCreate", reqParams)
-	resp, err := config.Client.Vloadbalancer.V2Api.CreateTargetGroup(reqParams)
-	LogResponse("resourceNcloudTargetGroupCreate", resp)
+	output, err := GetVpcLoadBalancerTargetGroup(r.config, id)
 	if err != nil {
-		LogErrorResponse("resourceNcloudTargetGroupCreate", err, reqParams)
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("not found target group(%s)", id))
+		return
 	}
 
-	d.SetId(ncloud.StringValue(resp.TargetGroupList[0].TargetGroupNo))
-	return resourceNcloudTargetGroupUpdate(ctx, d, meta)
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func resourceNcloudTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
+func (r *lbTargetGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lbTargetGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	tg, err := GetVpcLoadBalancerTargetGroup(config, d.Id())
+	output, err := GetVpcLoadBalancerTargetGroup(r.config, state.TargetGroupNo.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
 	}
 
-	if tg == nil {
-		d.SetId("")
-		return nil
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	tgMap := ConvertToMap(tg)
-	SetSingularResourceDataFromMapSchema(ResourceNcloudLbTargetGroup(), d, tgMap)
-	return nil
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
+func (r *lbTargetGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state lbTargetGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.TargetGroupNo.ValueString()
 
-	if d.HasChange("health_check") {
-		reqParams := &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest{
-			RegionCode:    &config.RegionCode,
-			TargetGroupNo: ncloud.String(d.Id()),
+	if !plan.HealthCheck.Equal(state.HealthCheck) {
+		healthCheck, diags := plan.healthCheck(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		healthChecks := d.Get("health_check").([]interface{})
-		if len(healthChecks) == 1 {
-			healthCheck := healthChecks[0].(map[string]interface{})
-			healthCheckProtocol := healthCheck["protocol"].(string)
-
-			reqParams.HealthCheckCycle = ncloud.Int32(int32(healthCheck["cycle"].(int)))
-			reqParams.HealthCheckDownThreshold = ncloud.Int32(int32(healthCheck["down_threshold"].(int)))
-			reqParams.HealthCheckPort = ncloud.Int32(int32(healthCheck["port"].(int)))
-			reqParams.HealthCheckUpThreshold = ncloud.Int32(int32(healthCheck["up_threshold"].(int)))
-
-			if healthCheckProtocol == "HTTP" || healthCheckProtocol == "HTTPS" {
-				reqParams.HealthCheckUrlPath = ncloud.String(healthCheck["url_path"].(string))
-				if healthCheck["http_method"] == "" {
-					return diag.FromErr(fmt.Errorf("http_method is required if the health check protocol type is HTTP or HTTPS."))
-				}
-				reqParams.HealthCheckHttpMethodTypeCode = ncloud.String(healthCheck["http_method"].(string))
+
+		// Health check can not be removed from the target group, so it is kept as is when unset.
+		if healthCheck != nil {
+			reqParams := &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest{
+				RegionCode:               &r.config.RegionCode,
+				TargetGroupNo:            ncloud.String(id),
+				HealthCheckCycle:         healthCheck.Cycle.ValueInt32Pointer(),
+				HealthCheckDownThreshold: healthCheck.DownThreshold.ValueInt32Pointer(),
+				HealthCheckUpThreshold:   healthCheck.UpThreshold.ValueInt32Pointer(),
+				HealthCheckPort:          healthCheck.Port.ValueInt32Pointer(),
 			}
-		}
-		LogCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
-		if _, err := config.Client.Vloadbalancer.V2Api.ChangeTargetGroupHealthCheckConfiguration(reqParams); err != nil {
-			LogErrorResponse("resourceNcloudTargetGroupUpdate", err, reqParams)
-			return diag.FromErr(err)
+			if healthCheck.isHttp() {
+				reqParams.HealthCheckUrlPath = knownStringPointer(healthCheck.UrlPath)
+				reqParams.HealthCheckHttpMethodTypeCode = knownStringPointer(healthCheck.HttpMethod)
+			}
+
+			LogCommonRequest("changeTargetGroupHealthCheckConfiguration", reqParams)
+			response, err := r.config.Client.Vloadbalancer.V2Api.ChangeTargetGroupHealthCheckConfiguration(reqParams)
+			if err != nil {
+				LogErrorResponse("changeTargetGroupHealthCheckConfiguration", err, reqParams)
+				resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+				return
+			}
+			LogResponse("changeTargetGroupHealthCheckConfiguration", response)
 		}
 	}
 
-	if d.HasChange("algorithm_type") || d.HasChange("use_sticky_session") || d.HasChange("use_proxy_protocol") {
-		reqParams := &vloadbalancer.ChangeTargetGroupConfigurationRequest{
-			RegionCode:        &config.RegionCode,
-			TargetGroupNo:     ncloud.String(d.Id()),
-			AlgorithmTypeCode: ncloud.String(d.Get("algorithm_type").(string)),
+	if !plan.AlgorithmType.Equal(state.AlgorithmType) || !plan.UseStickySession.Equal(state.UseStickySession) || !plan.UseProxyProtocol.Equal(state.UseProxyProtocol) {
+		if err := changeTargetGroupConfiguration(r.config, id, &plan); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
 		}
+	}
 
-		targetGroupProtocol := d.Get("protocol").(string)
-		switch targetGroupProtocol {
-		case "HTTP", "HTTPS", "TCP":
-			reqParams.UseStickySession = ncloud.Bool(d.Get("use_sticky_session").(bool))
-		case "PROXY_TCP":
-			reqParams.UseProxyProtocol = ncloud.Bool(d.Get("use_proxy_protocol").(bool))
+	if !plan.Description.Equal(state.Description) {
+		reqParams := &vloadbalancer.SetTargetGroupDescriptionRequest{
+			RegionCode:             &r.config.RegionCode,
+			TargetGroupNo:          ncloud.String(id),
+			TargetGroupDescription: ncloud.String(plan.Description.ValueString()),
 		}
 
-		if err := validateAlgorithmTypeByTargetGroupProtocol(*reqParams.AlgorithmTypeCode, targetGroupProtocol); err != nil {
-			return diag.FromErr(err)
-		}
-		LogCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
-		if _, err := config.Client.Vloadbalancer.V2Api.ChangeTargetGroupConfiguration(reqParams); err != nil {
-			return diag.FromErr(err)
+		LogCommonRequest("setTargetGroupDescription", reqParams)
+		response, err := r.config.Client.Vloadbalancer.V2Api.SetTargetGroupDescription(reqParams)
+		if err != nil {
+			LogErrorResponse("setTargetGroupDescription", err, reqParams)
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
 		}
+		LogResponse("setTargetGroupDescription", response)
+	}
+
+	output, err := GetVpcLoadBalancerTargetGroup(r.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("not found target group(%s)", id))
+		return
 	}
 
-	return resourceNcloudTargetGroupRead(ctx, d, meta)
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func resourceNcloudTargetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*conn.ProviderConfig)
+func (r *lbTargetGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state lbTargetGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	reqParams := &vloadbalancer.DeleteTargetGroupsRequest{
+		RegionCode:        &r.config.RegionCode,
+		TargetGroupNoList: []*string{ncloud.String(state.TargetGroupNo.ValueString())},
+	}
+
+	LogCommonRequest("deleteTargetGroups", reqParams)
+	response, err := r.config.Client.Vloadbalancer.V2Api.DeleteTargetGroups(reqParams)
+	if err != nil {
+		LogErrorResponse("deleteTargetGroups", err, reqParams)
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	LogResponse("deleteTargetGroups", response)
+}
+
+// changeTargetGroupConfiguration changes algorithm and session options.
+// Sticky session is only sent for HTTP, HTTPS and TCP, and proxy protocol only for PROXY_TCP.
+func changeTargetGroupConfiguration(config *conn.ProviderConfig, id string, plan *lbTargetGroupResourceModel) error {
+	reqParams := &vloadbalancer.ChangeTargetGroupConfigurationRequest{
 		RegionCode:        &config.RegionCode,
-		TargetGroupNoList: []*string{ncloud.String(d.Id())},
+		TargetGroupNo:     ncloud.String(id),
+		AlgorithmTypeCode: knownStringPointer(plan.AlgorithmType),
 	}
-	if _, err := config.Client.Vloadbalancer.V2Api.DeleteTargetGroups(reqParams); err != nil {
-		return diag.FromErr(err)
+
+	switch plan.Protocol.ValueString() {
+	case "HTTP", "HTTPS", "TCP":
+		reqParams.UseStickySession = knownBoolPointer(plan.UseStickySession)
+	case "PROXY_TCP":
+		reqParams.UseProxyProtocol = knownBoolPointer(plan.UseProxyProtocol)
 	}
 
+	LogCommonRequest("changeTargetGroupConfiguration", reqParams)
+	resp, err := config.Client.Vloadbalancer.V2Api.ChangeTargetGroupConfiguration(reqParams)
+	if err != nil {
+		LogErrorResponse("changeTargetGroupConfiguration", err, reqParams)
+		return err
+	}
+	LogResponse("changeTargetGroupConfiguration", resp)
+
 	return nil
 }
 
@@ -334,7 +595,7 @@ func convertVpcTargetGroup(tg *vloadbalancer.TargetGroup) *TargetGroup {
 				HealthCheckProtocolType:   tg.HealthCheckProtocolType.Code,
 				HealthCheckPort:           tg.HealthCheckPort,
 				HealthCheckUrlPath:        tg.HealthCheckUrlPath,
-				HealthCheckHttpMethodType: tg.HealthCheckHttpMethodType.Code,
+				HealthCheckHttpMethodType: GetCodePtrByCommonCode(tg.HealthCheckHttpMethodType),
 				HealthCheckCycle:          tg.HealthCheckCycle,
 				HealthCheckUpThreshold:    tg.HealthCheckUpThreshold,
 				HealthCheckDownThreshold:  tg.HealthCheckDownThreshold,
@@ -350,7 +611,7 @@ func validateAlgorithmTypeByTargetGroupProtocol(algorithmType string, protocol s
 	protocolMap["HTTPS"] = []string{"RR", "SIPHS", "LC"}
 	protocolMap["TCP"] = []string{"MH", "RR"}
 	if ok := ContainsInStringList(algorithmType, protocolMap[protocol]); !ok {
-		return fmt.Errorf("%s protocol is only suppoort %s algorithm types", protocol, protocolMap[protocol])
+		return fmt.Errorf("%s protocol is only support %s algorithm types", protocol, protocolMap[protocol])
 	}
 	return nil
 }
@@ -398,3 +659,127 @@ func validateVpcTargetGroupDuplicateName(config *conn.ProviderConfig, newName st
 
 	return nil
 }
+
+func isKnownValue(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+func knownStringPointer(v types.String) *string {
+	if !isKnownValue(v) {
+		return nil
+	}
+	return v.ValueStringPointer()
+}
+
+func knownInt32Pointer(v types.Int32) *int32 {
+	if !isKnownValue(v) {
+		return nil
+	}
+	return v.ValueInt32Pointer()
+}
+
+func knownBoolPointer(v types.Bool) *bool {
+	if !isKnownValue(v) {
+		return nil
+	}
+	return v.ValueBoolPointer()
+}
+
+type lbTargetGroupResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	TargetGroupNo          types.String `tfsdk:"target_group_no"`
+	Name                   types.String `tfsdk:"name"`
+	Port                   types.Int32  `tfsdk:"port"`
+	Protocol               types.String `tfsdk:"protocol"`
+	Description            types.String `tfsdk:"description"`
+	HealthCheck            types.List   `tfsdk:"health_check"`
+	TargetNoList           types.List   `tfsdk:"target_no_list"`
+	TargetType             types.String `tfsdk:"target_type"`
+	VpcNo                  types.String `tfsdk:"vpc_no"`
+	UseStickySession       types.Bool   `tfsdk:"use_sticky_session"`
+	UseProxyProtocol       types.Bool   `tfsdk:"use_proxy_protocol"`
+	AlgorithmType          types.String `tfsdk:"algorithm_type"`
+	LoadBalancerInstanceNo types.String `tfsdk:"load_balancer_instance_no"`
+}
+
+type lbTargetGroupHealthCheckModel struct {
+	Cycle         types.Int32  `tfsdk:"cycle"`
+	DownThreshold types.Int32  `tfsdk:"down_threshold"`
+	UpThreshold   types.Int32  `tfsdk:"up_threshold"`
+	HttpMethod    types.String `tfsdk:"http_method"`
+	Port          types.Int32  `tfsdk:"port"`
+	Protocol      types.String `tfsdk:"protocol"`
+	UrlPath       types.String `tfsdk:"url_path"`
+}
+
+func (m lbTargetGroupHealthCheckModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cycle":          types.Int32Type,
+		"down_threshold": types.Int32Type,
+		"up_threshold":   types.Int32Type,
+		"http_method":    types.StringType,
+		"port":           types.Int32Type,
+		"protocol":       types.StringType,
+		"url_path":       types.StringType,
+	}
+}
+
+func (m lbTargetGroupHealthCheckModel) isHttp() bool {
+	protocol := m.Protocol.ValueString()
+	return protocol == "HTTP" || protocol == "HTTPS"
+}
+
+func (m *lbTargetGroupResourceModel) healthCheck(ctx context.Context) (*lbTargetGroupHealthCheckModel, diag.Diagnostics) {
+	var healthChecks []lbTargetGroupHealthCheckModel
+	diags := m.HealthCheck.ElementsAs(ctx, &healthChecks, false)
+	if diags.HasError() || len(healthChecks) == 0 {
+		return nil, diags
+	}
+	return &healthChecks[0], diags
+}
+
+func (m *lbTargetGroupResourceModel) refreshFromOutput(ctx context.Context, output *TargetGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringPointerValue(output.TargetGroupNo)
+	m.TargetGroupNo = types.StringPointerValue(output.TargetGroupNo)
+	m.Name = types.StringPointerValue(output.TargetGroupName)
+	m.Port = types.Int32PointerValue(output.TargetGroupPort)
+	m.Protocol = types.StringPointerValue(output.TargetGroupProtocolType)
+	m.TargetType = types.StringPointerValue(output.TargetType)
+	m.VpcNo = types.StringPointerValue(output.VpcNo)
+	m.UseStickySession = types.BoolPointerValue(output.UseStickySession)
+	m.UseProxyProtocol = types.BoolPointerValue(output.UseProxyProtocol)
+	m.AlgorithmType = types.StringPointerValue(output.AlgorithmType)
+	m.LoadBalancerInstanceNo = types.StringPointerValue(output.LoadBalancerInstanceNo)
+
+	// Empty description is kept unset, unless it is set to empty.
+	if description := ncloud.StringValue(output.TargetGroupDescription); description != "" || !m.Description.IsNull() {
+		m.Description = types.StringValue(description)
+	}
+
+	targetNoList, d := types.ListValueFrom(ctx, types.StringType, ncloud.StringListValue(output.TargetNoList))
+	diags.Append(d...)
+	m.TargetNoList = targetNoList
+
+	healthCheckType := types.ObjectType{AttrTypes: lbTargetGroupHealthCheckModel{}.attrTypes()}
+
+	healthChecks := make([]lbTargetGroupHealthCheckModel, 0, len(output.HealthCheck))
+	for _, hc := range output.HealthCheck {
+		healthChecks = append(healthChecks, lbTargetGroupHealthCheckModel{
+			Cycle:         types.Int32PointerValue(hc.HealthCheckCycle),
+			DownThreshold: types.Int32PointerValue(hc.HealthCheckDownThreshold),
+			UpThreshold:   types.Int32PointerValue(hc.HealthCheckUpThreshold),
+			HttpMethod:    types.StringPointerValue(hc.HealthCheckHttpMethodType),
+			Port:          types.Int32PointerValue(hc.HealthCheckPort),
+			Protocol:      types.StringPointerValue(hc.HealthCheckProtocolType),
+			UrlPath:       types.StringPointerValue(hc.HealthCheckUrlPath),
+		})
+	}
+
+	healthCheck, d := types.ListValueFrom(ctx, healthCheckType, healthChecks)
+	diags.Append(d...)
+	m.HealthCheck = healthCheck
+
+	return diags
+}
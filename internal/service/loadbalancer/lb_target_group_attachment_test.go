@@ -122,7 +122,7 @@ resource "ncloud_lb_target_group" "test" {
   name        = "%[2]s"
   description = "for test"
 
-  health_check {
+  health_check = [{
 	protocol = "HTTP"
     http_method = "GET"
     port           = 8080
@@ -130,7 +130,7 @@ resource "ncloud_lb_target_group" "test" {
     cycle          = 30
     up_threshold   = 2 
     down_threshold = 2 
-  }
+  }]
 
   algorithm_type = "RR"
   use_sticky_session = true
@@ -14,55 +14,102 @@ import (
 )
 
 func DataSourceNcloudLbTargetGroup() *schema.Resource {
-	fieldMap := map[string]*schema.Schema{
-		"id": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
-		},
-		"description": {
-			Type:     schema.TypeString,
-			Computed: true,
-		},
-		"health_check": {
-			Type:     schema.TypeList,
-			Computed: true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"cycle": {
-						Type:     schema.TypeInt,
-						Computed: true,
-					},
-					"down_threshold": {
-						Type:     schema.TypeInt,
-						Computed: true,
-					},
-					"up_threshold": {
-						Type:     schema.TypeInt,
-						Computed: true,
-					},
-					"http_method": {
-						Type:     schema.TypeString,
-						Computed: true,
-					},
-					"port": {
-						Type:     schema.TypeInt,
-						Computed: true,
-					},
-					"protocol": {
-						Type:     schema.TypeString,
-						Computed: true,
-					},
-					"url_path": {
-						Type:     schema.TypeString,
-						Computed: true,
+	return &schema.Resource{
+		ReadContext: dataSourceNcloudLbTargetGroupRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"target_group_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health_check": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cycle": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"down_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"up_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"http_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
+			"target_no_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_sticky_session": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"use_proxy_protocol": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"algorithm_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"load_balancer_instance_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": DataSourceFiltersSchema(),
 		},
-		"filter": DataSourceFiltersSchema(),
 	}
-	return GetSingularDataSourceItemSchemaContext(ResourceNcloudLbTargetGroup(), fieldMap, dataSourceNcloudLbTargetGroupRead)
 }
 
 func dataSourceNcloudLbTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
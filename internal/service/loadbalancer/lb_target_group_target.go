@@ -0,0 +1,143 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// ResourceNcloudLbTargetGroupTarget registers a single target to the target group,
+// leaving the other targets of the group untouched.
+func ResourceNcloudLbTargetGroupTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNcloudLbTargetGroupTargetCreate,
+		ReadContext:   resourceNcloudLbTargetGroupTargetRead,
+		DeleteContext: resourceNcloudLbTargetGroupTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected TARGET_GROUP_NO:TARGET_NO", d.Id())
+				}
+				d.Set("target_group_no", idParts[0])
+				d.Set("target_no", idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"target_group_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"health_check_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health_check_response": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudLbTargetGroupTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+	targetGroupNo := d.Get("target_group_no").(string)
+	targetNo := d.Get("target_no").(string)
+
+	reqParams := &vloadbalancer.AddTargetRequest{
+		RegionCode:    &config.RegionCode,
+		TargetGroupNo: ncloud.String(targetGroupNo),
+		TargetNoList:  []*string{ncloud.String(targetNo)},
+	}
+
+	if err := waitForAddTarget(ctx, d, config, reqParams); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", targetGroupNo, targetNo))
+	return resourceNcloudLbTargetGroupTargetRead(ctx, d, meta)
+}
+
+func resourceNcloudLbTargetGroupTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	target, err := GetVpcLoadBalancerTarget(config, d.Get("target_group_no").(string), d.Get("target_no").(string))
+	if err != nil {
+		errorBody, _ := GetCommonErrorBody(err)
+		if errorBody.ReturnCode == TargetGroupAttachmentInvalidTargetGroupNoErrorCode {
+			log.Printf("[WARN] Target group does not exist, removing target %s", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if target == nil {
+		log.Printf("[WARN] Target does not exist, removing target %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("health_check_status", GetCodePtrByCommonCode(target.HealthCheckStatus))
+	d.Set("health_check_response", target.HealthCheckResponse)
+	return nil
+}
+
+func resourceNcloudLbTargetGroupTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*conn.ProviderConfig)
+
+	reqParams := &vloadbalancer.RemoveTargetRequest{
+		RegionCode:    &config.RegionCode,
+		TargetGroupNo: ncloud.String(d.Get("target_group_no").(string)),
+		TargetNoList:  []*string{ncloud.String(d.Get("target_no").(string))},
+	}
+
+	if err := waitForRemoveTarget(ctx, d, config, reqParams); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func GetVpcLoadBalancerTarget(config *conn.ProviderConfig, targetGroupNo, targetNo string) (*vloadbalancer.Target, error) {
+	reqParams := &vloadbalancer.GetTargetListRequest{
+		RegionCode:    &config.RegionCode,
+		TargetGroupNo: ncloud.String(targetGroupNo),
+	}
+
+	LogCommonRequest("getTargetList", reqParams)
+	resp, err := config.Client.Vloadbalancer.V2Api.GetTargetList(reqParams)
+	if err != nil {
+		LogErrorResponse("getTargetList", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getTargetList", resp)
+
+	for _, target := range resp.TargetList {
+		if ncloud.StringValue(target.TargetNo) == targetNo {
+			return target, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,119 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
+)
+
+func TestAccResourceNcloudLbTargetGroupTarget_basic(t *testing.T) {
+	targetGroupName := fmt.Sprintf("terraform-testacc-tgt-%s", acctest.RandString(5))
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_lb_target_group_target.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLbTargetGroupTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudLbTargetGroupTargetConfig(targetGroupName, testServerName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "target_group_no", "ncloud_lb_target_group.test", "target_group_no"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_no", "ncloud_server.static", "instance_no"),
+					resource.TestCheckResourceAttrSet(resourceName, "health_check_status"),
+					// Target registered by the attachment is kept alongside.
+					resource.TestCheckResourceAttr("ncloud_lb_target_group_attachment.test", "target_no_list.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"health_check_status", "health_check_response"},
+			},
+		},
+	})
+}
+
+func TestResourceNcloudLbTargetGroupTarget_fixture_basic(t *testing.T) {
+	gw := NewFakeAPIGateway(t, "lb_target_group_target_basic")
+	resourceName := "ncloud_lb_target_group_target.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if n := gw.Requests("/vloadbalancer/v2/addTarget"); n != 1 {
+				return fmt.Errorf("expected 1 addTarget request, got %d", n)
+			}
+			if n := gw.Requests("/vloadbalancer/v2/removeTarget"); n != 1 {
+				return fmt.Errorf("expected 1 removeTarget request, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "ncloud_lb_target_group_target" "test" {
+	target_group_no = "50001"
+	target_no       = "1000002"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "50001:1000002"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_status", "UP"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_response", "HTTP/1.1 200 OK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "50001:1000002",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLbTargetGroupTargetDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_lb_target_group_target" {
+			continue
+		}
+
+		target, err := loadbalancer.GetVpcLoadBalancerTarget(config, rs.Primary.Attributes["target_group_no"], rs.Primary.Attributes["target_no"])
+		if err != nil {
+			return err
+		}
+
+		if target != nil {
+			return fmt.Errorf("Target (%s) still exists in Target Group (%s)", rs.Primary.Attributes["target_no"], rs.Primary.Attributes["target_group_no"])
+		}
+	}
+	return nil
+}
+
+func testAccResourceNcloudLbTargetGroupTargetConfig(targetGroupName string, serverName string) string {
+	return testAccResourceNcloudLbTargetGroupAttachmentConfig(targetGroupName, serverName) + fmt.Sprintf(`
+resource "ncloud_server" "static" {
+	subnet_no = ncloud_subnet.test.subnet_no
+	name = "%[1]s-static"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.test.key_name
+}
+
+resource "ncloud_lb_target_group_target" "test" {
+  target_group_no = ncloud_lb_target_group.test.target_group_no
+  target_no       = ncloud_server.static.instance_no
+}
+`, serverName)
+}
@@ -2,6 +2,7 @@ package loadbalancer_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -48,10 +49,231 @@ func TestAccResourceNcloudLbTargetGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "vpc_no"),
 				),
 			},
+			{
+				Config: testAccResourceNcloudLbTargetGroupUpdateConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLbTargetGroupExists(resourceName, &tg, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "for update test"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.http_method", "HEAD"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.port", "8081"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.url_path", "/health"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.cycle", "60"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.up_threshold", "3"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.down_threshold", "4"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm_type", "LC"),
+					resource.TestCheckResourceAttr(resourceName, "use_sticky_session", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceNcloudLbTargetGroup_fixture_updateInPlace(t *testing.T) {
+	gw := NewFakeAPIGateway(t, "lb_target_group_update")
+	resourceName := "ncloud_lb_target_group.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			// Health check and description are changed without replacing the target group.
+			if n := gw.Requests("/vloadbalancer/v2/createTargetGroup"); n != 1 {
+				return fmt.Errorf("expected 1 createTargetGroup request, got %d", n)
+			}
+			if n := gw.Requests("/vloadbalancer/v2/changeTargetGroupHealthCheckConfiguration"); n != 1 {
+				return fmt.Errorf("expected 1 changeTargetGroupHealthCheckConfiguration request, got %d", n)
+			}
+			if n := gw.Requests("/vloadbalancer/v2/setTargetGroupDescription"); n != 1 {
+				return fmt.Errorf("expected 1 setTargetGroupDescription request, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudLbTargetGroupFixtureConfig("for test", 30, 2, "/monitor/l7check"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "50001"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_no", "50001"),
+					resource.TestCheckResourceAttr(resourceName, "description", "for test"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.cycle", "30"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm_type", "RR"),
+					resource.TestCheckResourceAttr(resourceName, "use_sticky_session", "true"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudLbTargetGroupFixtureConfig("updated", 60, 3, "/health"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "50001"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.cycle", "60"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.up_threshold", "3"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.url_path", "/health"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Health check can not be removed from the target group, so it is kept in state when unset.
+				Config: testAccResourceNcloudLbTargetGroupFixtureConfigWithoutHealthCheck("updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "health_check.0.cycle", "60"),
+				),
+			},
 		},
 	})
 }
 
+func TestResourceNcloudLbTargetGroup_fixture_healthCheckDrift(t *testing.T) {
+	NewFakeAPIGateway(t, "lb_target_group_drift")
+	resourceName := "ncloud_lb_target_group.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Health check cycle is changed outside of Terraform after creation.
+				Config:             testAccResourceNcloudLbTargetGroupFixtureConfig("for test", 30, 2, "/monitor/l7check"),
+				Check:              resource.TestCheckResourceAttr(resourceName, "health_check.0.cycle", "30"),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudLbTargetGroup_upgradeFromSDKv2(t *testing.T) {
+	name := fmt.Sprintf("terraform-testacc-tg-%s", acctest.RandString(5))
+	resourceName := "ncloud_lb_target_group.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { TestAccPreCheck(t) },
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckLbTargetGroupDestroy(state, TestAccProvider)
+		},
+		Steps: []resource.TestStep{
+			{
+				// Last release with the SDKv2 implementation. Health check is omitted, since the block syntax of it
+				// is not accepted anymore, and it is still read back into state from the API.
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"ncloud": {
+						Source:            "NaverCloudPlatform/ncloud",
+						VersionConstraint: "3.2.1",
+					},
+				},
+				Config: testAccResourceNcloudLbTargetGroupUpgradeConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "health_check.#", "1"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+				Config:                   testAccResourceNcloudLbTargetGroupUpgradeConfig(name),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func TestResourceNcloudLbTargetGroup_fixture_invalidCombination(t *testing.T) {
+	NewFakeAPIGateway(t, "lb_target_group_update")
+
+	testCases := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name: "algorithm",
+			config: `
+resource "ncloud_lb_target_group" "test" {
+	vpc_no         = "10001"
+	protocol       = "TCP"
+	algorithm_type = "LC"
+}
+`,
+			err: `TCP protocol is only support \[MH RR\] algorithm types`,
+		},
+		{
+			name: "health check protocol",
+			config: `
+resource "ncloud_lb_target_group" "test" {
+	vpc_no   = "10001"
+	protocol = "PROXY_TCP"
+
+	health_check = [{
+		protocol = "HTTP"
+		http_method = "GET"
+	}]
+}
+`,
+			err: `Health check protocol is only support TCP when target group protocol is\s+PROXY_TCP`,
+		},
+		{
+			name: "http method",
+			config: `
+resource "ncloud_lb_target_group" "test" {
+	vpc_no   = "10001"
+	protocol = "HTTP"
+
+	health_check = [{
+		protocol = "HTTP"
+	}]
+}
+`,
+			err: `http_method is required if the health check protocol type is HTTP or\s+HTTPS`,
+		},
+		{
+			name: "url path",
+			config: `
+resource "ncloud_lb_target_group" "test" {
+	vpc_no   = "10001"
+	protocol = "TCP"
+
+	health_check = [{
+		protocol = "TCP"
+		url_path = "/health"
+	}]
+}
+`,
+			err: `url_path is only supported if the health check protocol type is HTTP or\s+HTTPS`,
+		},
+		{
+			name: "proxy protocol",
+			config: `
+resource "ncloud_lb_target_group" "test" {
+	vpc_no             = "10001"
+	protocol           = "TCP"
+	use_proxy_protocol = true
+}
+`,
+			err: `use_proxy_protocol is only supported when target group protocol is\s+PROXY_TCP`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      tc.config,
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAccResourceNcloudLbTargetGroup_emptyTargetGroupName(t *testing.T) {
 	var tg loadbalancer.TargetGroup
 	resourceName := "ncloud_lb_target_group.test"
@@ -158,7 +380,7 @@ resource "ncloud_lb_target_group" "test" {
   name        = "%s"
   description = "for test"
 
-  health_check {
+  health_check = [{
 	protocol = "HTTP"
     http_method = "GET"
     port           = 8080
@@ -166,7 +388,7 @@ resource "ncloud_lb_target_group" "test" {
     cycle          = 30
     up_threshold   = 2 
     down_threshold = 2 
-  }
+  }]
 
   algorithm_type = "RR"
   use_sticky_session = true
@@ -196,7 +418,7 @@ resource "ncloud_lb_target_group" "test" {
   port        = 8080
   description = "for test"
 
-  health_check {
+  health_check = [{
 	protocol = "HTTP"
     http_method = "GET"
     port           = 8080
@@ -204,10 +426,107 @@ resource "ncloud_lb_target_group" "test" {
     cycle          = 30
     up_threshold   = 2 
     down_threshold = 2 
-  }
+  }]
 
   algorithm_type = "RR"
   use_sticky_session = true
 }
 `
 }
+
+func testAccResourceNcloudLbTargetGroupUpdateConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	ipv4_cidr_block    = "10.0.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	subnet             = "10.0.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "LOADB"
+}
+
+resource "ncloud_lb_target_group" "test" {
+  vpc_no   = ncloud_vpc.test.vpc_no
+  protocol = "HTTP"
+  target_type = "VSVR"
+  port        = 8080
+  name        = "%s"
+  description = "for update test"
+
+  health_check = [{
+	protocol = "HTTP"
+    http_method = "HEAD"
+    port           = 8081
+    url_path       = "/health"
+    cycle          = 60
+    up_threshold   = 3
+    down_threshold = 4
+  }]
+
+  algorithm_type = "LC"
+  use_sticky_session = false
+}
+`, name)
+}
+
+func testAccResourceNcloudLbTargetGroupFixtureConfig(description string, cycle, upThreshold int, urlPath string) string {
+	return fmt.Sprintf(`
+resource "ncloud_lb_target_group" "test" {
+  vpc_no      = "10001"
+  protocol    = "HTTP"
+  target_type = "VSVR"
+  port        = 8080
+  name        = "tf-tg"
+  description = "%[1]s"
+
+  health_check = [{
+    protocol       = "HTTP"
+    http_method    = "GET"
+    port           = 8080
+    url_path       = "%[4]s"
+    cycle          = %[2]d
+    up_threshold   = %[3]d
+    down_threshold = 2
+  }]
+
+  algorithm_type     = "RR"
+  use_sticky_session = true
+}
+`, description, cycle, upThreshold, urlPath)
+}
+
+func testAccResourceNcloudLbTargetGroupFixtureConfigWithoutHealthCheck(description string) string {
+	return fmt.Sprintf(`
+resource "ncloud_lb_target_group" "test" {
+  vpc_no      = "10001"
+  protocol    = "HTTP"
+  target_type = "VSVR"
+  port        = 8080
+  name        = "tf-tg"
+  description = "%[1]s"
+
+  algorithm_type     = "RR"
+  use_sticky_session = true
+}
+`, description)
+}
+
+func testAccResourceNcloudLbTargetGroupUpgradeConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+  ipv4_cidr_block = "10.0.0.0/16"
+}
+
+resource "ncloud_lb_target_group" "test" {
+  vpc_no      = ncloud_vpc.test.vpc_no
+  protocol    = "TCP"
+  target_type = "VSVR"
+  port        = 8080
+  name        = "%s"
+}
+`, name)
+}
@@ -119,7 +119,7 @@ resource "ncloud_lb_target_group" "test" {
   name        = "%s-tg"
   description = "for test"
 
-  health_check {
+  health_check = [{
 	protocol = "HTTP"
     http_method = "GET"
     port           = 8080
@@ -127,7 +127,7 @@ resource "ncloud_lb_target_group" "test" {
     cycle          = 30
     up_threshold   = 2 
     down_threshold = 2 
-  }
+  }]
 
   algorithm_type = "RR"
   use_sticky_session = true
@@ -51,3 +51,47 @@ type LoadBalancerListener struct {
 	LoadBalancerRuleNoList []*string `json:"rule_no_list"`
 	TargetGroupNo          *string   `json:"target_group_no,omitempty"`
 }
+
+type LoadBalancerRule struct {
+	LoadBalancerRuleNo     *string                      `json:"rule_no,omitempty"`
+	LoadBalancerListenerNo *string                      `json:"listener_no,omitempty"`
+	Priority               *int32                       `json:"priority,omitempty"`
+	ConditionList          []*LoadBalancerRuleCondition `json:"condition"`
+	ActionList             []*LoadBalancerRuleAction    `json:"action"`
+}
+
+type LoadBalancerRuleCondition struct {
+	RuleConditionType *string   `json:"type,omitempty"`
+	HostHeaderList    []*string `json:"host_header_values"`
+	PathPatternList   []*string `json:"path_pattern_values"`
+}
+
+type LoadBalancerRuleAction struct {
+	RuleActionType    *string              `json:"type,omitempty"`
+	TargetGroupList   []*TargetGroupWeight `json:"target_group"`
+	UseStickySession  *bool                `json:"use_sticky_session,omitempty"`
+	RedirectionAction []*RedirectionAction `json:"redirect"`
+}
+
+type TargetGroupWeight struct {
+	TargetGroupNo *string `json:"target_group_no,omitempty"`
+	Weight        *int32  `json:"weight,omitempty"`
+}
+
+type RedirectionAction struct {
+	Protocol   *string `json:"protocol,omitempty"`
+	Port       *string `json:"port,omitempty"`
+	Host       *string `json:"host,omitempty"`
+	Path       *string `json:"path,omitempty"`
+	Query      *string `json:"query,omitempty"`
+	StatusCode *string `json:"status_code,omitempty"`
+}
+
+type LoadBalancerListenerCertificate struct {
+	SslCertificateNo   *string `json:"ssl_certificate_no,omitempty"`
+	SslCertificateName *string `json:"ssl_certificate_name,omitempty"`
+	DomainAddress      *string `json:"domain,omitempty"`
+	ValidEndDate       *string `json:"valid_end_date,omitempty"`
+	IsDefault          *bool   `json:"is_default,omitempty"`
+	StatusName         *string `json:"status,omitempty"`
+}
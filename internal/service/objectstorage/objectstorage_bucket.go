@@ -2,11 +2,13 @@ package objectstorage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -293,3 +295,49 @@ func BucketNameValidator() []validator.String {
 		),
 	}
 }
+
+// isS3ErrorCode reports whether err is an Object Storage API error with one of given codes.
+func isS3ErrorCode(err error, codes ...string) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if apiErr.ErrorCode() == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// waitBucketConfigurationApplied polls until applied reports that the bucket configuration read back matches the requested one.
+// Errors with one of pendingCodes are retried, since a configuration is not always visible right after it is put.
+// Any other error stops waiting.
+func waitBucketConfigurationApplied(ctx context.Context, kind, bucketName string, pendingCodes []string, applied func() (bool, error)) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{APPLYING},
+		Target:  []string{APPLIED},
+		Refresh: func() (interface{}, string, error) {
+			ok, err := applied()
+			if err != nil && !isS3ErrorCode(err, pendingCodes...) {
+				return nil, "", err
+			}
+
+			if ok {
+				return bucketName, APPLIED, nil
+			}
+
+			return bucketName, APPLYING, nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bucket %s (%s) to be applied: %s", kind, bucketName, err)
+	}
+	return nil
+}
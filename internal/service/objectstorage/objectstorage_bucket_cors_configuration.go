@@ -0,0 +1,377 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketCORSConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketCORSConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketCORSConfigurationResource{}
+)
+
+func NewBucketCORSConfigurationResource() resource.Resource {
+	return &bucketCORSConfigurationResource{}
+}
+
+type bucketCORSConfigurationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketCORSConfigurationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"cors_rule": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"allowed_methods": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(
+									stringvalidator.OneOf("GET", "PUT", "HEAD", "POST", "DELETE"),
+								),
+							},
+						},
+						"allowed_origins": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"allowed_headers": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"expose_headers": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"max_age_seconds": schema.Int32Attribute{
+							Optional: true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *bucketCORSConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	if err := putBucketCORS(ctx, b.config, bucketName, plan.CORSRule); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := getBucketCORSRules(ctx, b.config, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(bucketName, output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketCORSConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := getBucketCORSRules(ctx, b.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(state.ID.ValueString(), output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketCORSConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	if err := putBucketCORS(ctx, b.config, bucketName, plan.CORSRule); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	output, err := getBucketCORSRules(ctx, b.config, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(bucketName, output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketCORSConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketCorsInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketCors reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketCors(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketCors response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketCORSConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_cors_configuration"
+}
+
+func (b *bucketCORSConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketCORSConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketCORS(ctx context.Context, config *conn.ProviderConfig, bucketName string, rules []corsRuleModel) error {
+	corsRules := expandCORSRules(rules)
+
+	reqParams := &s3.PutBucketCorsInput{
+		Bucket: ncloud.String(bucketName),
+		CORSConfiguration: &awsTypes.CORSConfiguration{
+			CORSRules: corsRules,
+		},
+	}
+
+	tflog.Info(ctx, "PutBucketCors reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketCors(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketCors response="+common.MarshalUncheckedString(response))
+
+	return waitBucketCORSApplied(ctx, config, bucketName, corsRules)
+}
+
+func waitBucketCORSApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string, rules []awsTypes.CORSRule) error {
+	return waitBucketConfigurationApplied(ctx, "cors", bucketName, []string{"NoSuchCORSConfiguration", "NoSuchBucket"}, func() (bool, error) {
+		output, err := config.Client.ObjectStorage.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+			Bucket: ncloud.String(bucketName),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return output != nil && reflect.DeepEqual(flattenCORSRules(rules), flattenCORSRules(output.CORSRules)), nil
+	})
+}
+
+// getBucketCORSRules returns nil without error when bucket has no cors configuration.
+func getBucketCORSRules(ctx context.Context, config *conn.ProviderConfig, bucketName string) ([]awsTypes.CORSRule, error) {
+	output, err := config.Client.ObjectStorage.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if isS3ErrorCode(err, "NoSuchCORSConfiguration", "NoSuchBucket") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if output == nil {
+		return nil, fmt.Errorf("invalid output from GetBucketCors")
+	}
+
+	tflog.Info(ctx, "GetBucketCors response="+common.MarshalUncheckedString(output))
+
+	return output.CORSRules, nil
+}
+
+type bucketCORSConfigurationResourceModel struct {
+	ID         types.String    `tfsdk:"id"`
+	BucketName types.String    `tfsdk:"bucket_name"`
+	CORSRule   []corsRuleModel `tfsdk:"cors_rule"`
+}
+
+type corsRuleModel struct {
+	ID             types.String   `tfsdk:"id"`
+	AllowedMethods []types.String `tfsdk:"allowed_methods"`
+	AllowedOrigins []types.String `tfsdk:"allowed_origins"`
+	AllowedHeaders []types.String `tfsdk:"allowed_headers"`
+	ExposeHeaders  []types.String `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int32    `tfsdk:"max_age_seconds"`
+}
+
+func (b *bucketCORSConfigurationResourceModel) refreshFromOutput(bucketName string, rules []awsTypes.CORSRule) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+	b.CORSRule = flattenCORSRules(rules)
+}
+
+func expandCORSRules(rules []corsRuleModel) []awsTypes.CORSRule {
+	result := make([]awsTypes.CORSRule, 0, len(rules))
+
+	for _, r := range rules {
+		result = append(result, awsTypes.CORSRule{
+			ID:             r.ID.ValueStringPointer(),
+			AllowedMethods: expandStringValues(r.AllowedMethods),
+			AllowedOrigins: expandStringValues(r.AllowedOrigins),
+			AllowedHeaders: expandStringValues(r.AllowedHeaders),
+			ExposeHeaders:  expandStringValues(r.ExposeHeaders),
+			MaxAgeSeconds:  r.MaxAgeSeconds.ValueInt32Pointer(),
+		})
+	}
+
+	return result
+}
+
+func flattenCORSRules(rules []awsTypes.CORSRule) []corsRuleModel {
+	result := make([]corsRuleModel, 0, len(rules))
+
+	for _, r := range rules {
+		rule := corsRuleModel{
+			ID:             types.StringNull(),
+			AllowedMethods: flattenStringValues(r.AllowedMethods),
+			AllowedOrigins: flattenStringValues(r.AllowedOrigins),
+			AllowedHeaders: flattenStringValues(r.AllowedHeaders),
+			ExposeHeaders:  flattenStringValues(r.ExposeHeaders),
+			MaxAgeSeconds:  types.Int32PointerValue(r.MaxAgeSeconds),
+		}
+
+		if r.ID != nil && *r.ID != "" {
+			rule.ID = types.StringPointerValue(r.ID)
+		}
+
+		result = append(result, rule)
+	}
+
+	return result
+}
+
+func expandStringValues(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+
+	return result
+}
+
+func flattenStringValues(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+
+	return result
+}
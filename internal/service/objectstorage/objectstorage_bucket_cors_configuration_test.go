@@ -0,0 +1,124 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_cors_configuration_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_cors_configuration.testing_cors"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSConfigurationConfig(bucketName, "https://www.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSConfigurationExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.1.allowed_methods.0", "GET"),
+				),
+			},
+			{
+				Config: testAccBucketCORSConfigurationConfig(bucketName, "https://static.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketCORSConfigurationExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_origins.0", "https://static.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketCORSConfigurationExists(n string, provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resource, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if resource.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := provider.Meta().(*conn.ProviderConfig)
+		resp, err := config.Client.ObjectStorage.GetBucketCors(context.Background(), &s3.GetBucketCorsInput{
+			Bucket: ncloud.String(resource.Primary.Attributes["bucket_name"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.CORSRules) == 0 {
+			return fmt.Errorf("Bucket cors configuration not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketCORSConfigurationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_bucket_cors_configuration" {
+			continue
+		}
+
+		resp, err := config.Client.ObjectStorage.GetBucketCors(context.Background(), &s3.GetBucketCorsInput{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket_name"]),
+		})
+		if err == nil && len(resp.CORSRules) > 0 {
+			return fmt.Errorf("Bucket cors configuration still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketCORSConfigurationConfig(bucketName, origin string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_cors_configuration" "testing_cors" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+
+			cors_rule = [
+				{
+					allowed_headers	= ["*"]
+					allowed_methods	= ["PUT", "POST"]
+					allowed_origins	= ["%[2]s"]
+					expose_headers	= ["ETag"]
+					max_age_seconds	= 3000
+				},
+				{
+					allowed_methods	= ["GET"]
+					allowed_origins	= ["*"]
+				},
+			]
+		}
+	`, bucketName, origin)
+}
@@ -0,0 +1,651 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketLifecycleConfigurationResource{}
+)
+
+func NewBucketLifecycleConfigurationResource() resource.Resource {
+	return &bucketLifecycleConfigurationResource{}
+}
+
+type bucketLifecycleConfigurationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketLifecycleConfigurationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"rule": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 1000),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+							Description: "Unique identifier for the rule",
+						},
+						"status": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(awsTypes.ExpirationStatusEnabled),
+									string(awsTypes.ExpirationStatusDisabled),
+								),
+							},
+						},
+						"filter": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"prefix": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+										stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("tags")),
+									},
+								},
+								"tags": schema.MapAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+									Validators: []validator.Map{
+										mapvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"days": schema.Int32Attribute{
+									Optional: true,
+									Validators: []validator.Int32{
+										int32validator.AtLeast(1),
+										int32validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("date")),
+									},
+								},
+								"date": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										lifecycleDateValidator(),
+									},
+									Description: "RFC3339 format date at midnight UTC. e.g. 2024-01-01T00:00:00Z",
+								},
+							},
+						},
+						"transition": schema.ListNestedAttribute{
+							Optional: true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.Int32Attribute{
+										Optional: true,
+										Validators: []validator.Int32{
+											int32validator.AtLeast(0),
+											int32validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("date")),
+										},
+									},
+									"date": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											lifecycleDateValidator(),
+										},
+									},
+									"storage_class": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"noncurrent_days": schema.Int32Attribute{
+									Required: true,
+									Validators: []validator.Int32{
+										int32validator.AtLeast(1),
+									},
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"days_after_initiation": schema.Int32Attribute{
+									Required: true,
+									Validators: []validator.Int32{
+										int32validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *bucketLifecycleConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	if err := putBucketLifecycleConfiguration(ctx, b.config, bucketName, plan.Rule); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := getBucketLifecycleRules(ctx, b.config, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(bucketName, output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketLifecycleConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := getBucketLifecycleRules(ctx, b.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(state.ID.ValueString(), output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketLifecycleConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	if err := putBucketLifecycleConfiguration(ctx, b.config, bucketName, plan.Rule); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	output, err := getBucketLifecycleRules(ctx, b.config, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(bucketName, output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketLifecycleConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketLifecycleInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketLifecycle reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketLifecycle(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketLifecycle response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketLifecycleConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_lifecycle_configuration"
+}
+
+func (b *bucketLifecycleConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketLifecycleConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketLifecycleConfiguration(ctx context.Context, config *conn.ProviderConfig, bucketName string, rules []lifecycleRuleModel) error {
+	lifecycleRules, err := expandLifecycleRules(rules)
+	if err != nil {
+		return err
+	}
+
+	reqParams := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: ncloud.String(bucketName),
+		LifecycleConfiguration: &awsTypes.BucketLifecycleConfiguration{
+			Rules: lifecycleRules,
+		},
+	}
+
+	tflog.Info(ctx, "PutBucketLifecycleConfiguration reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketLifecycleConfiguration(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketLifecycleConfiguration response="+common.MarshalUncheckedString(response))
+
+	return waitBucketLifecycleConfigurationApplied(ctx, config, bucketName, lifecycleRules)
+}
+
+func waitBucketLifecycleConfigurationApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string, rules []awsTypes.LifecycleRule) error {
+	return waitBucketConfigurationApplied(ctx, "lifecycle configuration", bucketName, []string{"NoSuchLifecycleConfiguration", "NoSuchBucket"}, func() (bool, error) {
+		output, err := config.Client.ObjectStorage.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: ncloud.String(bucketName),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return output != nil && lifecycleRulesEqual(rules, output.Rules), nil
+	})
+}
+
+// lifecycleRulesEqual compares rules by id in the same form as they are kept in state,
+// since the API may return them in another order or with a legacy prefix instead of a filter.
+func lifecycleRulesEqual(want, got []awsTypes.LifecycleRule) bool {
+	if len(want) != len(got) {
+		return false
+	}
+
+	gotRules := make(map[string]lifecycleRuleModel, len(got))
+	for _, r := range flattenLifecycleRules(got) {
+		gotRules[r.ID.ValueString()] = r
+	}
+
+	for _, r := range flattenLifecycleRules(want) {
+		if g, ok := gotRules[r.ID.ValueString()]; !ok || !reflect.DeepEqual(r, g) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type bucketLifecycleConfigurationResourceModel struct {
+	ID         types.String         `tfsdk:"id"`
+	BucketName types.String         `tfsdk:"bucket_name"`
+	Rule       []lifecycleRuleModel `tfsdk:"rule"`
+}
+
+type lifecycleRuleModel struct {
+	ID                             types.String                        `tfsdk:"id"`
+	Status                         types.String                        `tfsdk:"status"`
+	Filter                         *lifecycleFilterModel               `tfsdk:"filter"`
+	Expiration                     *lifecycleExpirationModel           `tfsdk:"expiration"`
+	Transition                     []lifecycleTransitionModel          `tfsdk:"transition"`
+	NoncurrentVersionExpiration    *lifecycleNoncurrentExpirationModel `tfsdk:"noncurrent_version_expiration"`
+	AbortIncompleteMultipartUpload *lifecycleAbortMultipartUploadModel `tfsdk:"abort_incomplete_multipart_upload"`
+}
+
+type lifecycleFilterModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+	Tags   types.Map    `tfsdk:"tags"`
+}
+
+type lifecycleExpirationModel struct {
+	Days types.Int32  `tfsdk:"days"`
+	Date types.String `tfsdk:"date"`
+}
+
+type lifecycleTransitionModel struct {
+	Days         types.Int32  `tfsdk:"days"`
+	Date         types.String `tfsdk:"date"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type lifecycleNoncurrentExpirationModel struct {
+	NoncurrentDays types.Int32 `tfsdk:"noncurrent_days"`
+}
+
+type lifecycleAbortMultipartUploadModel struct {
+	DaysAfterInitiation types.Int32 `tfsdk:"days_after_initiation"`
+}
+
+// getBucketLifecycleRules returns nil without error when bucket has no lifecycle configuration.
+func getBucketLifecycleRules(ctx context.Context, config *conn.ProviderConfig, bucketName string) ([]awsTypes.LifecycleRule, error) {
+	output, err := config.Client.ObjectStorage.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if isS3ErrorCode(err, "NoSuchLifecycleConfiguration", "NoSuchBucket") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if output == nil {
+		return nil, fmt.Errorf("invalid output from GetBucketLifecycleConfiguration")
+	}
+
+	tflog.Info(ctx, "GetBucketLifecycleConfiguration response="+common.MarshalUncheckedString(output))
+
+	return output.Rules, nil
+}
+
+func (b *bucketLifecycleConfigurationResourceModel) refreshFromOutput(bucketName string, rules []awsTypes.LifecycleRule) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+	b.Rule = sortLifecycleRulesByPrior(flattenLifecycleRules(rules), b.Rule)
+}
+
+// sortLifecycleRulesByPrior orders the rules read back like the prior plan or state, matching them by id, as the
+// API does not keep the order they were put in. Rules without a prior one follow in API order.
+func sortLifecycleRulesByPrior(rules, prior []lifecycleRuleModel) []lifecycleRuleModel {
+	index := make(map[string]int, len(prior))
+	for i, r := range prior {
+		index[r.ID.ValueString()] = i
+	}
+
+	position := func(r lifecycleRuleModel) int {
+		if i, ok := index[r.ID.ValueString()]; ok {
+			return i
+		}
+		return len(prior)
+	}
+
+	slices.SortStableFunc(rules, func(a, b lifecycleRuleModel) int {
+		return position(a) - position(b)
+	})
+
+	return rules
+}
+
+func expandLifecycleRules(rules []lifecycleRuleModel) ([]awsTypes.LifecycleRule, error) {
+	result := make([]awsTypes.LifecycleRule, 0, len(rules))
+
+	for _, r := range rules {
+		rule := awsTypes.LifecycleRule{
+			ID:     r.ID.ValueStringPointer(),
+			Status: awsTypes.ExpirationStatus(r.Status.ValueString()),
+			Filter: expandLifecycleFilter(r.Filter),
+		}
+
+		if r.Expiration != nil {
+			date, err := parseLifecycleDate(r.Expiration.Date)
+			if err != nil {
+				return nil, err
+			}
+
+			rule.Expiration = &awsTypes.LifecycleExpiration{
+				Days: r.Expiration.Days.ValueInt32Pointer(),
+				Date: date,
+			}
+		}
+
+		for _, t := range r.Transition {
+			date, err := parseLifecycleDate(t.Date)
+			if err != nil {
+				return nil, err
+			}
+
+			rule.Transitions = append(rule.Transitions, awsTypes.Transition{
+				Days:         t.Days.ValueInt32Pointer(),
+				Date:         date,
+				StorageClass: awsTypes.TransitionStorageClass(t.StorageClass.ValueString()),
+			})
+		}
+
+		if r.NoncurrentVersionExpiration != nil {
+			rule.NoncurrentVersionExpiration = &awsTypes.NoncurrentVersionExpiration{
+				NoncurrentDays: r.NoncurrentVersionExpiration.NoncurrentDays.ValueInt32Pointer(),
+			}
+		}
+
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule.AbortIncompleteMultipartUpload = &awsTypes.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: r.AbortIncompleteMultipartUpload.DaysAfterInitiation.ValueInt32Pointer(),
+			}
+		}
+
+		result = append(result, rule)
+	}
+
+	return result, nil
+}
+
+// Without filter, rule applies to every object in the bucket which is expressed as empty prefix.
+func expandLifecycleFilter(filter *lifecycleFilterModel) awsTypes.LifecycleRuleFilter {
+	if filter == nil {
+		return &awsTypes.LifecycleRuleFilterMemberPrefix{Value: ""}
+	}
+
+	var tags []awsTypes.Tag
+	for k, v := range filter.Tags.Elements() {
+		tags = append(tags, awsTypes.Tag{
+			Key:   ncloud.String(k),
+			Value: v.(types.String).ValueStringPointer(),
+		})
+	}
+
+	switch {
+	case len(tags) == 0:
+		return &awsTypes.LifecycleRuleFilterMemberPrefix{Value: filter.Prefix.ValueString()}
+	case len(tags) == 1 && filter.Prefix.IsNull():
+		return &awsTypes.LifecycleRuleFilterMemberTag{Value: tags[0]}
+	default:
+		return &awsTypes.LifecycleRuleFilterMemberAnd{
+			Value: awsTypes.LifecycleRuleAndOperator{
+				Prefix: filter.Prefix.ValueStringPointer(),
+				Tags:   tags,
+			},
+		}
+	}
+}
+
+func flattenLifecycleRules(rules []awsTypes.LifecycleRule) []lifecycleRuleModel {
+	result := make([]lifecycleRuleModel, 0, len(rules))
+
+	for _, r := range rules {
+		rule := lifecycleRuleModel{
+			ID:     types.StringPointerValue(r.ID),
+			Status: types.StringValue(string(r.Status)),
+			Filter: flattenLifecycleFilter(r.Filter, r.Prefix),
+		}
+
+		if r.Expiration != nil {
+			rule.Expiration = &lifecycleExpirationModel{
+				Days: types.Int32PointerValue(r.Expiration.Days),
+				Date: flattenLifecycleDate(r.Expiration.Date),
+			}
+		}
+
+		for _, t := range r.Transitions {
+			rule.Transition = append(rule.Transition, lifecycleTransitionModel{
+				Days:         types.Int32PointerValue(t.Days),
+				Date:         flattenLifecycleDate(t.Date),
+				StorageClass: types.StringValue(string(t.StorageClass)),
+			})
+		}
+
+		if r.NoncurrentVersionExpiration != nil {
+			rule.NoncurrentVersionExpiration = &lifecycleNoncurrentExpirationModel{
+				NoncurrentDays: types.Int32PointerValue(r.NoncurrentVersionExpiration.NoncurrentDays),
+			}
+		}
+
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule.AbortIncompleteMultipartUpload = &lifecycleAbortMultipartUploadModel{
+				DaysAfterInitiation: types.Int32PointerValue(r.AbortIncompleteMultipartUpload.DaysAfterInitiation),
+			}
+		}
+
+		result = append(result, rule)
+	}
+
+	return result
+}
+
+// Empty prefix means no filter, and legacy top level prefix is treated same as prefix filter.
+func flattenLifecycleFilter(filter awsTypes.LifecycleRuleFilter, legacyPrefix *string) *lifecycleFilterModel {
+	tagsType := types.MapNull(types.StringType)
+
+	switch v := filter.(type) {
+	case *awsTypes.LifecycleRuleFilterMemberPrefix:
+		if v.Value == "" {
+			return nil
+		}
+		return &lifecycleFilterModel{
+			Prefix: types.StringValue(v.Value),
+			Tags:   tagsType,
+		}
+	case *awsTypes.LifecycleRuleFilterMemberTag:
+		return &lifecycleFilterModel{
+			Prefix: types.StringNull(),
+			Tags:   flattenLifecycleTags([]awsTypes.Tag{v.Value}),
+		}
+	case *awsTypes.LifecycleRuleFilterMemberAnd:
+		prefix := types.StringNull()
+		if v.Value.Prefix != nil && *v.Value.Prefix != "" {
+			prefix = types.StringPointerValue(v.Value.Prefix)
+		}
+		return &lifecycleFilterModel{
+			Prefix: prefix,
+			Tags:   flattenLifecycleTags(v.Value.Tags),
+		}
+	}
+
+	if legacyPrefix != nil && *legacyPrefix != "" {
+		return &lifecycleFilterModel{
+			Prefix: types.StringPointerValue(legacyPrefix),
+			Tags:   tagsType,
+		}
+	}
+
+	return nil
+}
+
+func flattenLifecycleTags(tags []awsTypes.Tag) types.Map {
+	if len(tags) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(tags))
+	for _, tag := range tags {
+		elements[ncloud.StringValue(tag.Key)] = types.StringPointerValue(tag.Value)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func parseLifecycleDate(date types.String) (*time.Time, error) {
+	if date.IsNull() || date.IsUnknown() {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, date.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid lifecycle date %q: %s", date.ValueString(), err)
+	}
+
+	return &t, nil
+}
+
+func flattenLifecycleDate(date *time.Time) types.String {
+	if date == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(date.UTC().Format(time.RFC3339))
+}
+
+func lifecycleDateValidator() validator.String {
+	return stringvalidator.RegexMatches(
+		regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T00:00:00Z$`),
+		"Date must be in RFC3339 format at midnight UTC. e.g. 2024-01-01T00:00:00Z",
+	)
+}
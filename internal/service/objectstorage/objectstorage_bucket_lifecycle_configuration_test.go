@@ -0,0 +1,162 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_lifecycle_configuration_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_lifecycle_configuration.testing_lifecycle"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig(bucketName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "expire-logs"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.abort_incomplete_multipart_upload.days_after_initiation", "7"),
+				),
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig(bucketName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.days", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceNcloudObjectStorage_fixture_bucket_lifecycle_configuration_emptyFilter(t *testing.T) {
+	NewFakeAPIGateway(t, "bucket_lifecycle_configuration_validation")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "ncloud_objectstorage_bucket_lifecycle_configuration" "testing_lifecycle" {
+	bucket_name = "tf-test-lifecycle"
+
+	rule = [
+		{
+			id     = "expire-all"
+			status = "Enabled"
+			filter = {}
+			expiration = {
+				days = 30
+			}
+		},
+	]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`At least one attribute out of`),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketLifecycleConfigurationExists(n string, provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resource, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if resource.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := provider.Meta().(*conn.ProviderConfig)
+		resp, err := config.Client.ObjectStorage.GetBucketLifecycleConfiguration(context.Background(), &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: ncloud.String(resource.Primary.Attributes["bucket_name"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Rules) == 0 {
+			return fmt.Errorf("Bucket lifecycle configuration not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketLifecycleConfigurationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_bucket_lifecycle_configuration" {
+			continue
+		}
+
+		resp, err := config.Client.ObjectStorage.GetBucketLifecycleConfiguration(context.Background(), &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket_name"]),
+		})
+		if err == nil && len(resp.Rules) > 0 {
+			return fmt.Errorf("Bucket lifecycle configuration still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketLifecycleConfigurationConfig(bucketName string, days int) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_lifecycle_configuration" "testing_lifecycle" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+
+			rule = [
+				{
+					id		= "expire-logs"
+					status	= "Enabled"
+					filter	= {
+						prefix = "logs/"
+					}
+					expiration = {
+						days = %[2]d
+					}
+				},
+				{
+					id		= "abort-multipart"
+					status	= "Enabled"
+					abort_incomplete_multipart_upload = {
+						days_after_initiation = 7
+					}
+				},
+			]
+		}
+	`, bucketName, days)
+}
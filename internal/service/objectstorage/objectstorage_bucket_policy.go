@@ -0,0 +1,241 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketPolicyResource{}
+	_ resource.ResourceWithConfigure   = &bucketPolicyResource{}
+	_ resource.ResourceWithImportState = &bucketPolicyResource{}
+)
+
+func NewBucketPolicyResource() resource.Resource {
+	return &bucketPolicyResource{}
+}
+
+type bucketPolicyResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketPolicyResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"policy": schema.StringAttribute{
+				Required:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "JSON policy document",
+			},
+		},
+	}
+}
+
+func (b *bucketPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	if err := putBucketPolicy(ctx, b.config, bucketName, plan.Policy.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := getBucketPolicy(ctx, b.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(state.ID.ValueString(), output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	// Skip API call when only formatting of the document has changed.
+	if !common.JSONEquivalent(plan.Policy.ValueString(), state.Policy.ValueString()) {
+		if err := putBucketPolicy(ctx, b.config, bucketName, plan.Policy.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(bucketName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketPolicyInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketPolicy reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketPolicy(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketPolicy response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_policy"
+}
+
+func (b *bucketPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketPolicy(ctx context.Context, config *conn.ProviderConfig, bucketName, policy string) error {
+	normalized, err := common.NormalizeJSON(policy)
+	if err != nil {
+		return fmt.Errorf("policy is not valid JSON: %s", err)
+	}
+
+	reqParams := &s3.PutBucketPolicyInput{
+		Bucket: ncloud.String(bucketName),
+		Policy: ncloud.String(normalized),
+	}
+
+	tflog.Info(ctx, "PutBucketPolicy reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketPolicy(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketPolicy response="+common.MarshalUncheckedString(response))
+
+	return waitBucketPolicyApplied(ctx, config, bucketName, normalized)
+}
+
+func waitBucketPolicyApplied(ctx context.Context, config *conn.ProviderConfig, bucketName, policy string) error {
+	return waitBucketConfigurationApplied(ctx, "policy", bucketName, []string{"NoSuchBucketPolicy", "NoSuchBucket"}, func() (bool, error) {
+		output, err := config.Client.ObjectStorage.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+			Bucket: ncloud.String(bucketName),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return output != nil && output.Policy != nil && common.JSONEquivalent(*output.Policy, policy), nil
+	})
+}
+
+// getBucketPolicy returns nil without error when bucket has no policy.
+func getBucketPolicy(ctx context.Context, config *conn.ProviderConfig, bucketName string) (*string, error) {
+	output, err := config.Client.ObjectStorage.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if isS3ErrorCode(err, "NoSuchBucketPolicy", "NoSuchBucket") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if output == nil || output.Policy == nil {
+		return nil, nil
+	}
+
+	tflog.Info(ctx, "GetBucketPolicy response="+common.MarshalUncheckedString(output))
+
+	return output.Policy, nil
+}
+
+type bucketPolicyResourceModel struct {
+	ID         types.String         `tfsdk:"id"`
+	BucketName types.String         `tfsdk:"bucket_name"`
+	Policy     jsontypes.Normalized `tfsdk:"policy"`
+}
+
+func (b *bucketPolicyResourceModel) refreshFromOutput(bucketName string, policy *string) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+	b.Policy = jsontypes.NewNormalizedPointerValue(policy)
+}
@@ -0,0 +1,138 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_policy_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_policy.testing_policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyConfig(bucketName, "10.0.0.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketPolicyExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket_name", "ncloud_objectstorage_bucket.testing_bucket", "bucket_name"),
+				),
+			},
+			{
+				// Reformatting the document only updates state without changing the stored policy
+				Config: testAccBucketPolicyConfigReformatted(bucketName, "10.0.0.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketPolicyExists(resourceName, TestAccProvider),
+				),
+			},
+			{
+				Config: testAccBucketPolicyConfig(bucketName, "10.0.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketPolicyExists(resourceName, TestAccProvider),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+		},
+	})
+}
+
+func testAccCheckBucketPolicyExists(n string, provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resource, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if resource.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := provider.Meta().(*conn.ProviderConfig)
+		resp, err := config.Client.ObjectStorage.GetBucketPolicy(context.Background(), &s3.GetBucketPolicyInput{
+			Bucket: ncloud.String(resource.Primary.Attributes["bucket_name"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Policy == nil {
+			return fmt.Errorf("Bucket policy not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketPolicyConfig(bucketName, cidr string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_policy" "testing_policy" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+			policy					= jsonencode({
+				Version = "2012-10-17"
+				Statement = [
+					{
+						Sid       = "AllowFromOffice"
+						Effect    = "Allow"
+						Principal = "*"
+						Action    = ["s3:GetObject"]
+						Resource  = ["arn:aws:s3:::%[1]s/*"]
+						Condition = {
+							IpAddress = {
+								"aws:SourceIp" = ["%[2]s"]
+							}
+						}
+					}
+				]
+			})
+		}
+	`, bucketName, cidr)
+}
+
+func testAccBucketPolicyConfigReformatted(bucketName, cidr string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_policy" "testing_policy" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+			policy					= <<EOF
+{
+  "Statement": [
+    {
+      "Resource": ["arn:aws:s3:::%[1]s/*"],
+      "Action": ["s3:GetObject"],
+      "Condition": {"IpAddress": {"aws:SourceIp": ["%[2]s"]}},
+      "Principal": "*",
+      "Effect": "Allow",
+      "Sid": "AllowFromOffice"
+    }
+  ],
+  "Version": "2012-10-17"
+}
+EOF
+		}
+	`, bucketName, cidr)
+}
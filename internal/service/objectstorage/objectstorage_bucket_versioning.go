@@ -0,0 +1,267 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketVersioningResource{}
+	_ resource.ResourceWithConfigure   = &bucketVersioningResource{}
+	_ resource.ResourceWithImportState = &bucketVersioningResource{}
+)
+
+func NewBucketVersioningResource() resource.Resource {
+	return &bucketVersioningResource{}
+}
+
+type bucketVersioningResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketVersioningResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"status": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(awsTypes.BucketVersioningStatusEnabled),
+						string(awsTypes.BucketVersioningStatusSuspended),
+					),
+				},
+				Description: "Versioning state of the bucket",
+			},
+		},
+	}
+}
+
+func (b *bucketVersioningResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+	status := awsTypes.BucketVersioningStatus(plan.Status.ValueString())
+
+	if err := putBucketVersioning(ctx, b.config, bucketName, status); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := getBucketVersioning(ctx, b.config, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	if output == nil {
+		resp.Diagnostics.AddError("CREATING ERROR", fmt.Sprintf("bucket (%s) not found", bucketName))
+		return
+	}
+
+	plan.refreshFromOutput(bucketName, output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketVersioningResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := getBucketVersioning(ctx, b.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(state.ID.ValueString(), output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketVersioningResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Status.Equal(state.Status) {
+		bucketName := state.BucketName.ValueString()
+		status := awsTypes.BucketVersioningStatus(plan.Status.ValueString())
+
+		if err := putBucketVersioning(ctx, b.config, bucketName, status); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+
+		output, err := getBucketVersioning(ctx, b.config, bucketName)
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+		if output == nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", fmt.Sprintf("bucket (%s) not found", bucketName))
+			return
+		}
+
+		plan.refreshFromOutput(bucketName, output)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Versioning can not be turned off once enabled, so deleting this resource suspends versioning of the bucket.
+func (b *bucketVersioningResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketVersioning(ctx, b.config, state.BucketName.ValueString(), awsTypes.BucketVersioningStatusSuspended); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+	}
+}
+
+func (b *bucketVersioningResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_versioning"
+}
+
+func (b *bucketVersioningResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketVersioningResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketVersioning(ctx context.Context, config *conn.ProviderConfig, bucketName string, status awsTypes.BucketVersioningStatus) error {
+	reqParams := &s3.PutBucketVersioningInput{
+		Bucket: ncloud.String(bucketName),
+		VersioningConfiguration: &awsTypes.VersioningConfiguration{
+			Status: status,
+		},
+	}
+
+	tflog.Info(ctx, "PutBucketVersioning reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketVersioning(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketVersioning response="+common.MarshalUncheckedString(response))
+
+	return waitBucketVersioningApplied(ctx, config, bucketName, status)
+}
+
+func waitBucketVersioningApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string, status awsTypes.BucketVersioningStatus) error {
+	return waitBucketConfigurationApplied(ctx, "versioning", bucketName, []string{"NoSuchBucket"}, func() (bool, error) {
+		output, err := config.Client.ObjectStorage.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+			Bucket: ncloud.String(bucketName),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return output != nil && flattenBucketVersioningStatus(output.Status).ValueString() == string(status), nil
+	})
+}
+
+// getBucketVersioning returns nil without error when bucket does not exist.
+func getBucketVersioning(ctx context.Context, config *conn.ProviderConfig, bucketName string) (*s3.GetBucketVersioningOutput, error) {
+	output, err := config.Client.ObjectStorage.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if isS3ErrorCode(err, "NoSuchBucket") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if output == nil {
+		return nil, fmt.Errorf("invalid output from GetBucketVersioning")
+	}
+
+	tflog.Info(ctx, "GetBucketVersioning response="+common.MarshalUncheckedString(output))
+
+	return output, nil
+}
+
+type bucketVersioningResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (b *bucketVersioningResourceModel) refreshFromOutput(bucketName string, output *s3.GetBucketVersioningOutput) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+	b.Status = flattenBucketVersioningStatus(output.Status)
+}
+
+// flattenBucketVersioningStatus returns Suspended for a bucket which versioning was never enabled on,
+// as versioning is off the same as a suspended one.
+func flattenBucketVersioningStatus(status awsTypes.BucketVersioningStatus) types.String {
+	if status == "" {
+		return types.StringValue(string(awsTypes.BucketVersioningStatusSuspended))
+	}
+
+	return types.StringValue(string(status))
+}
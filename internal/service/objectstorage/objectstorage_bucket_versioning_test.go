@@ -0,0 +1,107 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_versioning_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_versioning.testing_versioning"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketVersioningConfig(bucketName, "Enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketVersioningStatus(resourceName, "Enabled", TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_bucket_versioning_update(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_versioning.testing_versioning"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketVersioningConfig(bucketName, "Enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketVersioningStatus(resourceName, "Enabled", TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+				),
+			},
+			{
+				Config: testAccBucketVersioningConfig(bucketName, "Suspended"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketVersioningStatus(resourceName, "Suspended", TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "status", "Suspended"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketVersioningStatus(n, status string, provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resource, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if resource.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := provider.Meta().(*conn.ProviderConfig)
+		resp, err := config.Client.ObjectStorage.GetBucketVersioning(context.Background(), &s3.GetBucketVersioningInput{
+			Bucket: ncloud.String(resource.Primary.Attributes["bucket_name"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if string(resp.Status) != status {
+			return fmt.Errorf("Bucket versioning status is %s, expected %s", resp.Status, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketVersioningConfig(bucketName, status string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_versioning" "testing_versioning" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+			status					= "%[2]s"
+		}
+	`, bucketName, status)
+}
@@ -0,0 +1,515 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketWebsiteConfigurationResource{}
+)
+
+func NewBucketWebsiteConfigurationResource() resource.Resource {
+	return &bucketWebsiteConfigurationResource{}
+}
+
+type bucketWebsiteConfigurationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketWebsiteConfigurationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	protocolValidator := stringvalidator.OneOf(
+		string(awsTypes.ProtocolHttp),
+		string(awsTypes.ProtocolHttps),
+	)
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"index_document": schema.SingleNestedAttribute{
+				Optional: true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("redirect_all_requests_to")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"suffix": schema.StringAttribute{
+						Required:    true,
+						Description: "Suffix that is appended to a request for a directory. e.g. index.html",
+					},
+				},
+			},
+			"error_document": schema.SingleNestedAttribute{
+				Optional: true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("redirect_all_requests_to")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Required:    true,
+						Description: "Object key to use when a 4XX class error occurs",
+					},
+				},
+			},
+			"redirect_all_requests_to": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"host_name": schema.StringAttribute{
+						Required: true,
+					},
+					"protocol": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							protocolValidator,
+						},
+					},
+				},
+			},
+			"routing_rule": schema.ListNestedAttribute{
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("redirect_all_requests_to")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"condition": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"http_error_code_returned_equals": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("key_prefix_equals")),
+									},
+								},
+								"key_prefix_equals": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						"redirect": schema.SingleNestedAttribute{
+							Required: true,
+							Attributes: map[string]schema.Attribute{
+								"host_name": schema.StringAttribute{
+									Optional: true,
+								},
+								"http_redirect_code": schema.StringAttribute{
+									Optional: true,
+								},
+								"protocol": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										protocolValidator,
+									},
+								},
+								"replace_key_prefix_with": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("replace_key_with")),
+									},
+								},
+								"replace_key_with": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"bucket_domain_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Virtual-hosted style domain of the bucket on the Object Storage endpoint. It is not a website endpoint",
+			},
+		},
+	}
+}
+
+func (b *bucketWebsiteConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := plan.BucketName.ValueString()
+
+	if err := putBucketWebsite(ctx, b.config, bucketName, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := getBucketWebsite(ctx, b.config, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(b.config, bucketName, output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketWebsiteConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := getBucketWebsite(ctx, b.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(b.config, state.ID.ValueString(), output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketWebsiteConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.BucketName.ValueString()
+
+	if err := putBucketWebsite(ctx, b.config, bucketName, &plan); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	output, err := getBucketWebsite(ctx, b.config, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(b.config, bucketName, output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketWebsiteConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketWebsiteInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketWebsite reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketWebsite(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketWebsite response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketWebsiteConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_website_configuration"
+}
+
+func (b *bucketWebsiteConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketWebsiteConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketWebsite(ctx context.Context, config *conn.ProviderConfig, bucketName string, plan *bucketWebsiteConfigurationResourceModel) error {
+	website := plan.expandWebsiteConfiguration()
+
+	reqParams := &s3.PutBucketWebsiteInput{
+		Bucket:               ncloud.String(bucketName),
+		WebsiteConfiguration: website,
+	}
+
+	tflog.Info(ctx, "PutBucketWebsite reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketWebsite(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketWebsite response="+common.MarshalUncheckedString(response))
+
+	return waitBucketWebsiteApplied(ctx, config, bucketName, website)
+}
+
+func waitBucketWebsiteApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string, website *awsTypes.WebsiteConfiguration) error {
+	var want bucketWebsiteConfigurationResourceModel
+	want.refreshFromOutput(config, bucketName, &s3.GetBucketWebsiteOutput{
+		IndexDocument:         website.IndexDocument,
+		ErrorDocument:         website.ErrorDocument,
+		RedirectAllRequestsTo: website.RedirectAllRequestsTo,
+		RoutingRules:          website.RoutingRules,
+	})
+
+	return waitBucketConfigurationApplied(ctx, "website configuration", bucketName, []string{"NoSuchWebsiteConfiguration", "NoSuchBucket"}, func() (bool, error) {
+		output, err := config.Client.ObjectStorage.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{
+			Bucket: ncloud.String(bucketName),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		var got bucketWebsiteConfigurationResourceModel
+		got.refreshFromOutput(config, bucketName, output)
+
+		return output != nil && reflect.DeepEqual(want, got), nil
+	})
+}
+
+// getBucketWebsite returns nil without error when bucket has no website configuration.
+func getBucketWebsite(ctx context.Context, config *conn.ProviderConfig, bucketName string) (*s3.GetBucketWebsiteOutput, error) {
+	output, err := config.Client.ObjectStorage.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if isS3ErrorCode(err, "NoSuchWebsiteConfiguration", "NoSuchBucket") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if output == nil {
+		return nil, fmt.Errorf("invalid output from GetBucketWebsite")
+	}
+
+	tflog.Info(ctx, "GetBucketWebsite response="+common.MarshalUncheckedString(output))
+
+	return output, nil
+}
+
+type bucketWebsiteConfigurationResourceModel struct {
+	ID                    types.String               `tfsdk:"id"`
+	BucketName            types.String               `tfsdk:"bucket_name"`
+	IndexDocument         *websiteIndexDocumentModel `tfsdk:"index_document"`
+	ErrorDocument         *websiteErrorDocumentModel `tfsdk:"error_document"`
+	RedirectAllRequestsTo *websiteRedirectAllModel   `tfsdk:"redirect_all_requests_to"`
+	RoutingRule           []websiteRoutingRuleModel  `tfsdk:"routing_rule"`
+	BucketDomainName      types.String               `tfsdk:"bucket_domain_name"`
+}
+
+type websiteIndexDocumentModel struct {
+	Suffix types.String `tfsdk:"suffix"`
+}
+
+type websiteErrorDocumentModel struct {
+	Key types.String `tfsdk:"key"`
+}
+
+type websiteRedirectAllModel struct {
+	HostName types.String `tfsdk:"host_name"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+type websiteRoutingRuleModel struct {
+	Condition *websiteConditionModel `tfsdk:"condition"`
+	Redirect  *websiteRedirectModel  `tfsdk:"redirect"`
+}
+
+type websiteConditionModel struct {
+	HttpErrorCodeReturnedEquals types.String `tfsdk:"http_error_code_returned_equals"`
+	KeyPrefixEquals             types.String `tfsdk:"key_prefix_equals"`
+}
+
+type websiteRedirectModel struct {
+	HostName             types.String `tfsdk:"host_name"`
+	HttpRedirectCode     types.String `tfsdk:"http_redirect_code"`
+	Protocol             types.String `tfsdk:"protocol"`
+	ReplaceKeyPrefixWith types.String `tfsdk:"replace_key_prefix_with"`
+	ReplaceKeyWith       types.String `tfsdk:"replace_key_with"`
+}
+
+func (b *bucketWebsiteConfigurationResourceModel) expandWebsiteConfiguration() *awsTypes.WebsiteConfiguration {
+	result := &awsTypes.WebsiteConfiguration{}
+
+	if b.IndexDocument != nil {
+		result.IndexDocument = &awsTypes.IndexDocument{
+			Suffix: b.IndexDocument.Suffix.ValueStringPointer(),
+		}
+	}
+
+	if b.ErrorDocument != nil {
+		result.ErrorDocument = &awsTypes.ErrorDocument{
+			Key: b.ErrorDocument.Key.ValueStringPointer(),
+		}
+	}
+
+	if b.RedirectAllRequestsTo != nil {
+		result.RedirectAllRequestsTo = &awsTypes.RedirectAllRequestsTo{
+			HostName: b.RedirectAllRequestsTo.HostName.ValueStringPointer(),
+			Protocol: awsTypes.Protocol(b.RedirectAllRequestsTo.Protocol.ValueString()),
+		}
+	}
+
+	for _, r := range b.RoutingRule {
+		rule := awsTypes.RoutingRule{}
+
+		if r.Condition != nil {
+			rule.Condition = &awsTypes.Condition{
+				HttpErrorCodeReturnedEquals: r.Condition.HttpErrorCodeReturnedEquals.ValueStringPointer(),
+				KeyPrefixEquals:             r.Condition.KeyPrefixEquals.ValueStringPointer(),
+			}
+		}
+
+		if r.Redirect != nil {
+			rule.Redirect = &awsTypes.Redirect{
+				HostName:             r.Redirect.HostName.ValueStringPointer(),
+				HttpRedirectCode:     r.Redirect.HttpRedirectCode.ValueStringPointer(),
+				Protocol:             awsTypes.Protocol(r.Redirect.Protocol.ValueString()),
+				ReplaceKeyPrefixWith: r.Redirect.ReplaceKeyPrefixWith.ValueStringPointer(),
+				ReplaceKeyWith:       r.Redirect.ReplaceKeyWith.ValueStringPointer(),
+			}
+		}
+
+		result.RoutingRules = append(result.RoutingRules, rule)
+	}
+
+	return result
+}
+
+func (b *bucketWebsiteConfigurationResourceModel) refreshFromOutput(config *conn.ProviderConfig, bucketName string, output *s3.GetBucketWebsiteOutput) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+
+	if output == nil {
+		output = &s3.GetBucketWebsiteOutput{}
+	}
+
+	b.BucketDomainName = types.StringValue(fmt.Sprintf("%s.%s", bucketName, objectStorageHost(config)))
+
+	b.IndexDocument = nil
+	if output.IndexDocument != nil {
+		b.IndexDocument = &websiteIndexDocumentModel{
+			Suffix: types.StringPointerValue(output.IndexDocument.Suffix),
+		}
+	}
+
+	b.ErrorDocument = nil
+	if output.ErrorDocument != nil {
+		b.ErrorDocument = &websiteErrorDocumentModel{
+			Key: types.StringPointerValue(output.ErrorDocument.Key),
+		}
+	}
+
+	b.RedirectAllRequestsTo = nil
+	if output.RedirectAllRequestsTo != nil {
+		b.RedirectAllRequestsTo = &websiteRedirectAllModel{
+			HostName: types.StringPointerValue(output.RedirectAllRequestsTo.HostName),
+			Protocol: flattenWebsiteProtocol(output.RedirectAllRequestsTo.Protocol),
+		}
+	}
+
+	b.RoutingRule = nil
+	for _, r := range output.RoutingRules {
+		rule := websiteRoutingRuleModel{}
+
+		if r.Condition != nil {
+			rule.Condition = &websiteConditionModel{
+				HttpErrorCodeReturnedEquals: types.StringPointerValue(r.Condition.HttpErrorCodeReturnedEquals),
+				KeyPrefixEquals:             types.StringPointerValue(r.Condition.KeyPrefixEquals),
+			}
+		}
+
+		if r.Redirect != nil {
+			rule.Redirect = &websiteRedirectModel{
+				HostName:             types.StringPointerValue(r.Redirect.HostName),
+				HttpRedirectCode:     types.StringPointerValue(r.Redirect.HttpRedirectCode),
+				Protocol:             flattenWebsiteProtocol(r.Redirect.Protocol),
+				ReplaceKeyPrefixWith: types.StringPointerValue(r.Redirect.ReplaceKeyPrefixWith),
+				ReplaceKeyWith:       types.StringPointerValue(r.Redirect.ReplaceKeyWith),
+			}
+		}
+
+		b.RoutingRule = append(b.RoutingRule, rule)
+	}
+}
+
+func flattenWebsiteProtocol(protocol awsTypes.Protocol) types.String {
+	if protocol == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(protocol))
+}
+
+// objectStorageHost returns host of the endpoint which the object storage client is resolved to,
+// including the one overridden by endpoints.s3 of provider configuration.
+func objectStorageHost(config *conn.ProviderConfig) string {
+	endpoint := ncloud.StringValue(config.Client.ObjectStorage.Options().BaseEndpoint)
+
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		return u.Host
+	}
+
+	return strings.TrimSuffix(endpoint, "/")
+}
@@ -1,19 +1,32 @@
 package objectstorage
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -44,17 +57,17 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	file, err := os.Open(plan.Source.ValueString())
+	body, err := openObjectBody(&plan)
 	if err != nil {
-		resp.Diagnostics.AddError("CREATING ERROR", "invalid source path")
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	defer file.Close()
+	defer body.Close()
 
 	reqParams := &s3.PutObjectInput{
 		Bucket: plan.Bucket.ValueStringPointer(),
 		Key:    plan.Key.ValueStringPointer(),
-		Body:   file,
+		Body:   body,
 	}
 
 	if !plan.ContentEncoding.IsNull() && !plan.ContentEncoding.IsUnknown() {
@@ -73,9 +86,17 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "PutObject reqParams="+common.MarshalUncheckedString(reqParams))
+	if !plan.CacheControl.IsNull() && !plan.CacheControl.IsUnknown() {
+		reqParams.CacheControl = plan.CacheControl.ValueStringPointer()
+	}
+
+	if !plan.ContentDisposition.IsNull() && !plan.ContentDisposition.IsUnknown() {
+		reqParams.ContentDisposition = plan.ContentDisposition.ValueStringPointer()
+	}
 
-	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
+	reqParams.Metadata = expandObjectMetadata(plan.Metadata)
+
+	output, err := uploadObject(ctx, o.config, reqParams, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
@@ -85,18 +106,27 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "PutObject response="+common.MarshalUncheckedString(output))
-
 	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
 
+	plannedETag := plan.ETag
+
 	plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Configured etag is kept as planned, mismatch with uploaded object is reported as drift on next read.
+	if !plannedETag.IsUnknown() && !plannedETag.IsNull() {
+		plan.ETag = plannedETag
+	}
+
+	if output.UploadID != "" {
+		plan.PartsCount = types.Int64Value(int64(len(output.CompletedParts)))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -113,6 +143,12 @@ func (o *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Key:    plan.Key.ValueStringPointer(),
 	}
 
+	// In versioned bucket, permanently delete exact version which this resource uploaded only when forced.
+	// Otherwise a delete marker is left and previous versions are kept.
+	if plan.ForceDestroy.ValueBool() && !plan.VersionId.IsNull() && !plan.VersionId.IsUnknown() && plan.VersionId.ValueString() != "" {
+		reqParams.VersionId = plan.VersionId.ValueStringPointer()
+	}
+
 	tflog.Info(ctx, "DeleteObject reqParams="+common.MarshalUncheckedString(reqParams))
 
 	response, err := o.config.Client.ObjectStorage.DeleteObject(ctx, reqParams)
@@ -123,7 +159,7 @@ func (o *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	tflog.Info(ctx, "DeleteObject response="+common.MarshalUncheckedString(response))
 
-	if err := waitObjectDeleted(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
+	if err := waitObjectDeleted(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString(), reqParams.VersionId); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
 	}
 }
@@ -171,12 +207,76 @@ func (o *objectResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				Description: "(Required) Name of the object once it is in the bucket",
 			},
 			"source": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("content"),
+						path.MatchRoot("content_base64"),
+					),
+				},
+				Description: "Path of the object. Conflicts with `content` and `content_base64`",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Literal string value to use as the object content. Conflicts with `source` and `content_base64`",
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[A-Za-z0-9+/]*={0,2}$`),
+						"must be a standard base64 encoded string",
+					),
+				},
+				Description: "Base64-encoded data that will be decoded and uploaded as raw bytes for the object content. Conflicts with `source` and `content`",
+			},
+			"source_hash": schema.StringAttribute{
+				Optional:    true,
+				Description: "Triggers re-upload of the object when the value changes. e.g. filemd5(\"path/to/source\")",
+			},
+			"cache_control": schema.StringAttribute{
+				Optional:    true,
+				Description: "Removing it from configuration clears the header of the object",
+			},
+			"content_disposition": schema.StringAttribute{
+				Optional:    true,
+				Description: "Removing it from configuration clears the header of the object",
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[^A-Z]+$`),
+							"must be lowercase, since metadata keys are stored in lowercase",
+						),
+					),
+				},
+				Description: "Map of user defined metadata(x-amz-meta-*) to store with the object. Removing it from configuration clears the metadata of the object",
+			},
+			"multipart_part_size": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(5, 5120),
+				},
+				Description: "Part size in MiB for multipart upload. Objects larger than this size are uploaded in parts. Default: 5",
+			},
+			"multipart_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
 				},
-				Description: "(Required) Path of the object",
+				Description: "Number of parts to upload in parallel for multipart upload. Default: 5",
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Permanently delete the version of the object uploaded by this resource in a versioned bucket, instead of leaving a delete marker. Default: false",
 			},
 			"accept_ranges": schema.StringAttribute{
 				Computed: true,
@@ -195,7 +295,9 @@ func (o *objectResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				Optional: true,
 			},
 			"etag": schema.StringAttribute{
-				Computed: true,
+				Computed:    true,
+				Optional:    true,
+				Description: "Triggers re-upload of the object when the value changes. e.g. filemd5(\"path/to/source\")",
 			},
 			"expiration": schema.StringAttribute{
 				Computed: true,
@@ -226,21 +328,34 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Changing only the multipart upload options does not require uploading the object again.
+	if !plan.hasUploadChange(state) {
+		plan.PartsCount = state.PartsCount
+
+		plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
 	reqParams := &s3.PutObjectInput{
 		Bucket: state.Bucket.ValueStringPointer(),
 		Key:    state.Key.ValueStringPointer(),
 	}
 
-	// get body from plan with source path or existing object
-	if !plan.Source.Equal(state.Source) {
-		file, err := os.Open(plan.Source.ValueString())
+	// get body from plan with source, content or existing object
+	if plan.hasBodyChange(state) {
+		body, err := openObjectBody(&plan)
 		if err != nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", "invalid source path")
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
 			return
 		}
-		defer file.Close()
+		defer body.Close()
 
-		reqParams.Body = file
+		reqParams.Body = body
 	} else {
 		// Prevent wasting of GetObject operation
 		if !plan.ContentType.Equal(state.ContentType) && o.config.RegionCode == "JPN" {
@@ -287,9 +402,17 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		reqParams.ContentType = plan.ContentType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "PutObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+	if !plan.CacheControl.IsNull() && !plan.CacheControl.IsUnknown() {
+		reqParams.CacheControl = plan.CacheControl.ValueStringPointer()
+	}
+
+	if !plan.ContentDisposition.IsNull() && !plan.ContentDisposition.IsUnknown() {
+		reqParams.ContentDisposition = plan.ContentDisposition.ValueStringPointer()
+	}
+
+	reqParams.Metadata = expandObjectMetadata(plan.Metadata)
 
-	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
+	output, err := uploadObject(ctx, o.config, reqParams, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
 		return
@@ -299,18 +422,27 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "PutObject at update operation response="+common.MarshalUncheckedString(output))
-
 	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
 		return
 	}
 
+	plannedETag := plan.ETag
+
 	plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Configured etag is kept as planned, mismatch with uploaded object is reported as drift on next read.
+	if !plannedETag.IsUnknown() && !plannedETag.IsNull() {
+		plan.ETag = plannedETag
+	}
+
+	if output.UploadID != "" {
+		plan.PartsCount = types.Int64Value(int64(len(output.CompletedParts)))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -327,6 +459,7 @@ func (o *objectResource) ImportState(ctx context.Context, req resource.ImportSta
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), bucketName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
 }
 
 func (o *objectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -377,14 +510,15 @@ func waitObjectUploaded(ctx context.Context, config *conn.ProviderConfig, bucket
 	return nil
 }
 
-func waitObjectDeleted(ctx context.Context, config *conn.ProviderConfig, bucketName, key string) error {
+func waitObjectDeleted(ctx context.Context, config *conn.ProviderConfig, bucketName, key string, versionId *string) error {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{DELETING},
 		Target:  []string{DELETED},
 		Refresh: func() (interface{}, string, error) {
 			output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
-				Bucket: &bucketName,
-				Key:    &key,
+				Bucket:    &bucketName,
+				Key:       &key,
+				VersionId: versionId,
 			})
 			if output != nil {
 				return output, DELETING, nil
@@ -412,6 +546,15 @@ type objectResourceModel struct {
 	Bucket                  types.String `tfsdk:"bucket"`
 	Key                     types.String `tfsdk:"key"`
 	Source                  types.String `tfsdk:"source"`
+	Content                 types.String `tfsdk:"content"`
+	ContentBase64           types.String `tfsdk:"content_base64"`
+	SourceHash              types.String `tfsdk:"source_hash"`
+	CacheControl            types.String `tfsdk:"cache_control"`
+	ContentDisposition      types.String `tfsdk:"content_disposition"`
+	Metadata                types.Map    `tfsdk:"metadata"`
+	MultipartPartSize       types.Int64  `tfsdk:"multipart_part_size"`
+	MultipartConcurrency    types.Int64  `tfsdk:"multipart_concurrency"`
+	ForceDestroy            types.Bool   `tfsdk:"force_destroy"`
 	AcceptRanges            types.String `tfsdk:"accept_ranges"`
 	ContentEncoding         types.String `tfsdk:"content_encoding"`
 	ContentLanguage         types.String `tfsdk:"content_language"`
@@ -461,15 +604,32 @@ func (o *objectResourceModel) refreshFromOutput(ctx context.Context, config *con
 	}
 
 	if !types.StringPointerValue(output.ETag).IsNull() || !types.StringPointerValue(output.ETag).IsUnknown() {
-		o.ETag = types.StringPointerValue(output.ETag)
+		// ETag header is quoted, trim it to be comparable with filemd5() and md5()
+		etag := strings.Trim(ncloud.StringValue(output.ETag), "\"")
+
+		// ETag of an object uploaded with multipart upload is not an MD5 digest of the content, so it never matches
+		// a configured filemd5(). Configured MD5 is kept for it instead of being reported as drift.
+		if !isMultipartETag(etag) || o.ETag.IsNull() || o.ETag.IsUnknown() || isMultipartETag(o.ETag.ValueString()) {
+			o.ETag = types.StringValue(etag)
+		}
+	}
+
+	o.CacheControl = types.StringPointerValue(output.CacheControl)
+	o.ContentDisposition = types.StringPointerValue(output.ContentDisposition)
+	// Configured empty map is kept, since the object has no metadata either way.
+	if len(output.Metadata) > 0 || o.Metadata.IsNull() || o.Metadata.IsUnknown() || len(o.Metadata.Elements()) > 0 {
+		o.Metadata = flattenObjectMetadata(output.Metadata)
 	}
 
 	if !types.StringPointerValue(output.Expiration).IsNull() || !types.StringPointerValue(output.Expiration).IsUnknown() {
 		o.Expiration = types.StringPointerValue(output.Expiration)
 	}
 
-	if !types.Int32PointerValue(output.PartsCount).IsNull() || !types.Int32PointerValue(output.PartsCount).IsUnknown() {
+	// HeadObject returns parts count only when part number is requested, so keep the count from upload.
+	if output.PartsCount != nil {
 		o.PartsCount = common.Int64ValueFromInt32(output.PartsCount)
+	} else if o.PartsCount.IsUnknown() {
+		o.PartsCount = types.Int64Null()
 	}
 
 	if !types.StringPointerValue(output.VersionId).IsNull() || !types.StringPointerValue(output.VersionId).IsUnknown() {
@@ -485,6 +645,139 @@ func (o *objectResourceModel) refreshFromOutput(ctx context.Context, config *con
 	}
 }
 
+// hasBodyChange reports whether object body has to be uploaded again from the plan.
+func (o *objectResourceModel) hasBodyChange(state objectResourceModel) bool {
+	return !o.Source.Equal(state.Source) ||
+		!o.Content.Equal(state.Content) ||
+		!o.ContentBase64.Equal(state.ContentBase64) ||
+		!o.SourceHash.Equal(state.SourceHash) ||
+		(!o.ETag.IsUnknown() && !o.ETag.IsNull() && !o.ETag.Equal(state.ETag))
+}
+
+// hasUploadChange reports whether object has to be uploaded again, ignoring multipart upload options.
+func (o *objectResourceModel) hasUploadChange(state objectResourceModel) bool {
+	changed := func(plan, state types.String) bool {
+		return !plan.IsUnknown() && !plan.Equal(state)
+	}
+
+	return o.hasBodyChange(state) ||
+		changed(o.ContentType, state.ContentType) ||
+		!o.CacheControl.Equal(state.CacheControl) ||
+		!o.ContentDisposition.Equal(state.ContentDisposition) ||
+		(!o.Metadata.IsUnknown() && !o.Metadata.Equal(state.Metadata))
+}
+
+// uploadObject uploads object with upload manager, which switches to multipart upload when body is larger than part size.
+// Parts of a failed multipart upload are aborted, only for the upload started here.
+func uploadObject(ctx context.Context, config *conn.ProviderConfig, reqParams *s3.PutObjectInput, plan *objectResourceModel) (*manager.UploadOutput, error) {
+	uploader := manager.NewUploader(config.Client.ObjectStorage, func(u *manager.Uploader) {
+		if !plan.MultipartPartSize.IsNull() && !plan.MultipartPartSize.IsUnknown() {
+			u.PartSize = plan.MultipartPartSize.ValueInt64() * 1024 * 1024
+		}
+		if !plan.MultipartConcurrency.IsNull() && !plan.MultipartConcurrency.IsUnknown() {
+			u.Concurrency = int(plan.MultipartConcurrency.ValueInt64())
+		}
+		// Upload manager aborts with the upload context and ignores the error, which leaves the parts
+		// when the upload is interrupted. Abort is made by abortFailedMultipartUpload instead.
+		u.LeavePartsOnError = true
+	})
+
+	tflog.Info(ctx, "Upload reqParams="+common.MarshalUncheckedString(reqParams))
+
+	output, err := uploader.Upload(ctx, reqParams)
+	if err != nil {
+		var failure manager.MultiUploadFailure
+		if errors.As(err, &failure) {
+			if abortErr := abortFailedMultipartUpload(ctx, config, reqParams, failure.UploadID()); abortErr != nil {
+				return nil, fmt.Errorf("%s, and failed to abort multipart upload (%s): %s", err, failure.UploadID(), abortErr)
+			}
+		}
+		return nil, err
+	}
+
+	tflog.Info(ctx, "Upload response="+common.MarshalUncheckedString(output))
+
+	return output, nil
+}
+
+// abortFailedMultipartUpload aborts the multipart upload started by uploadObject, even after the upload is cancelled.
+func abortFailedMultipartUpload(ctx context.Context, config *conn.ProviderConfig, reqParams *s3.PutObjectInput, uploadId string) error {
+	abortParams := &s3.AbortMultipartUploadInput{
+		Bucket:   reqParams.Bucket,
+		Key:      reqParams.Key,
+		UploadId: ncloud.String(uploadId),
+	}
+
+	tflog.Info(ctx, "AbortMultipartUpload reqParams="+common.MarshalUncheckedString(abortParams))
+
+	_, err := config.Client.ObjectStorage.AbortMultipartUpload(context.WithoutCancel(ctx), abortParams)
+	if err != nil && !isS3ErrorCode(err, "NoSuchUpload") {
+		return err
+	}
+
+	return nil
+}
+
+// openObjectBody opens the object body from one of source, content or content_base64.
+func openObjectBody(plan *objectResourceModel) (io.ReadSeekCloser, error) {
+	switch {
+	case !plan.Source.IsNull() && !plan.Source.IsUnknown():
+		file, err := os.Open(plan.Source.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid source path: %s", err)
+		}
+		return file, nil
+	case !plan.ContentBase64.IsNull() && !plan.ContentBase64.IsUnknown():
+		decoded, err := base64.StdEncoding.DecodeString(plan.ContentBase64.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid content_base64: %s", err)
+		}
+		return objectContentBody{bytes.NewReader(decoded)}, nil
+	default:
+		return objectContentBody{bytes.NewReader([]byte(plan.Content.ValueString()))}, nil
+	}
+}
+
+type objectContentBody struct {
+	*bytes.Reader
+}
+
+func (objectContentBody) Close() error {
+	return nil
+}
+
+// isMultipartETag reports whether the ETag is of an object uploaded with multipart upload, formatted as
+// <MD5 digest of part digests>-<parts count>.
+func isMultipartETag(etag string) bool {
+	return strings.Contains(etag, "-")
+}
+
+func expandObjectMetadata(metadata types.Map) map[string]string {
+	if metadata.IsNull() || metadata.IsUnknown() {
+		return nil
+	}
+
+	result := make(map[string]string, len(metadata.Elements()))
+	for k, v := range metadata.Elements() {
+		result[k] = v.(types.String).ValueString()
+	}
+
+	return result
+}
+
+func flattenObjectMetadata(metadata map[string]string) types.Map {
+	if len(metadata) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(metadata))
+	for k, v := range metadata {
+		elements[k] = types.StringValue(v)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
 func ObjectIDGenerator(bucketName, key string) string {
 	return fmt.Sprintf("%s/%s", bucketName, key)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -154,6 +155,152 @@ func TestAccResourceNcloudObjectStorage_object_update_content_type(t *testing.T)
 	})
 }
 
+func TestAccResourceNcloudObjectStorage_object_content(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	key := fmt.Sprintf("test/key/%s.json", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectContentConfig(bucketName, key, "content for upload testing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content", "content for upload testing"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "edc56f0e9bf4eb4f1f767f67ae5853be"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=3600"),
+					resource.TestCheckResourceAttr(resourceName, "content_disposition", "attachment"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.owner", "terraform"),
+				),
+			},
+			{
+				Config: testAccObjectContentConfig(bucketName, key, "new content for update testing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content", "new content for update testing"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "30"),
+				),
+			},
+			{
+				// Removed headers and metadata are cleared.
+				Config: testAccObjectContentWithoutHeadersConfig(bucketName, key, "new content for update testing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckNoResourceAttr(resourceName, "cache_control"),
+					resource.TestCheckNoResourceAttr(resourceName, "content_disposition"),
+					resource.TestCheckNoResourceAttr(resourceName, "metadata.%"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_content_base64(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	key := fmt.Sprintf("test/key/%s.bin", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectContentBase64Config(bucketName, key, "dGVzdGluZyBiYXNlNjQgY29udGVudA=="),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "22"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_source_hash(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	key := "test/key/" + sourceName
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	tmpFile := CreateTempFile(t, "content for file upload testing", sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSourceHashConfig(bucketName, key, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "31"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte("changed content for file upload testing"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectSourceHashConfig(bucketName, key, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "39"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_multipart(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.bin", acctest.RandString(5))
+	key := "test/key/" + sourceName
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	// 12 MiB of content is uploaded in 3 parts with 5 MiB part size
+	tmpFile := CreateTempFile(t, strings.Repeat("a", 12*1024*1024), sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectMultipartConfig(bucketName, key, source, 5, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "12582912"),
+					resource.TestCheckResourceAttr(resourceName, "parts_count", "3"),
+				),
+			},
+			{
+				// changing upload options only must not upload object again
+				Config: testAccObjectMultipartConfig(bucketName, key, source, 5, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", "4"),
+					resource.TestCheckResourceAttr(resourceName, "parts_count", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectExists(n string, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resource, ok := s.RootModule().Resources[n]
@@ -233,6 +380,80 @@ func testAccObjectContentType(bucketName, key, source, contentType string) strin
 	}`, bucketName, key, source, contentType)
 }
 
+func testAccObjectContentConfig(bucketName, key, content string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 				= "%[2]s"
+		content				= "%[3]s"
+		cache_control		= "max-age=3600"
+		content_disposition	= "attachment"
+
+		metadata = {
+			owner = "terraform"
+		}
+	}`, bucketName, key, content)
+}
+
+func testAccObjectContentWithoutHeadersConfig(bucketName, key, content string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 				= "%[2]s"
+		content				= "%[3]s"
+	}`, bucketName, key, content)
+}
+
+func testAccObjectContentBase64Config(bucketName, key, contentBase64 string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 				= "%[2]s"
+		content_base64		= "%[3]s"
+	}`, bucketName, key, contentBase64)
+}
+
+func testAccObjectSourceHashConfig(bucketName, key, source string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 				= "%[2]s"
+		source				= "%[3]s"
+		source_hash			= filemd5("%[3]s")
+	}`, bucketName, key, source)
+}
+
+func testAccObjectMultipartConfig(bucketName, key, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket					= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 					= "%[2]s"
+		source					= "%[3]s"
+		multipart_part_size		= %[4]d
+		multipart_concurrency	= %[5]d
+	}`, bucketName, key, source, partSize, concurrency)
+}
+
 func CreateTempFile(t *testing.T, content, key string) *os.File {
 	tmpFile, err := os.CreateTemp("", key)
 	if err != nil {
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"log"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,6 +25,8 @@ const (
 	BlockStorageStatusCodeCreate     = "CREAT"
 	BlockStorageStatusCodeInit       = "INIT"
 	BlockStorageStatusCodeAttach     = "ATTAC"
+	BlockStorageOperationCodeNull    = "NULL"
+	BlockStorageOperationCodeChange  = "CHNG"
 	BlockStorageStatusNameInit       = "initialized"
 	BlockStorageStatusNameCreating   = "creating"
 	BlockStorageStatusNameOptimizing = "optimizing"
@@ -51,9 +55,17 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(6 * conn.DefaultTimeout),
 			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
 		},
 
+		CustomizeDiff: customdiff.ValidateChange("size", func(ctx context.Context, old, new, meta interface{}) error {
+			if old.(int) > 0 && new.(int) < old.(int) {
+				return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", new, old)
+			}
+			return nil
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"server_instance_no": {
 				Type:     schema.TypeString,
@@ -121,6 +133,11 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"stop_instance_before_resizing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"return_protection": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -258,39 +275,9 @@ func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("size") {
-		o, n := d.GetChange("size")
-
-		if o.(int) >= n.(int) {
-			return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
-		}
-
-		// If server instance attached block storage, detach first
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if d.Get("stop_instance_before_detaching").(bool) {
-				log.Printf("[INFO] Start Instance %s after detaching block storage", d.Get("server_instance_no").(string))
-				if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-					return err
-				}
-			}
-
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
-			}
-
-			if err := detachThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-				return err
-			}
-		}
-
-		if err := changeBlockStorageSize(d, config); err != nil {
+		if err := expandBlockStorageSize(d, config); err != nil {
 			return err
 		}
-
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
-			}
-		}
 	}
 
 	if d.HasChange("return_protection") {
@@ -635,30 +622,98 @@ func waitForBlockStorageAttachment(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func changeBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	var err error
-	if d.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeXen {
-		err = changeVpcBlockStorageVolumeSize(d, config)
-	} else {
-		err = changeVpcBlockStorageInstance(d, config)
+// expandBlockStorageSize grows the block storage. KVM block storage is grown in place. Attached XEN block storage is
+// detached, resized and attached again, unless stop_instance_before_resizing is set to resize it in place.
+func expandBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	serverInstanceNo := d.Get("server_instance_no").(string)
+	if d.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeXen && len(serverInstanceNo) > 0 &&
+		!d.Get("stop_instance_before_resizing").(bool) {
+		return detachThenResizeBlockStorage(d, config, serverInstanceNo)
 	}
 
-	if err != nil {
+	return resizeBlockStorage(config, d.Id(), d.Get("hypervisor_type").(string), serverInstanceNo,
+		d.Get("size").(int), d.Get("stop_instance_before_resizing").(bool), false, d.Timeout(schema.TimeoutUpdate))
+}
+
+func detachThenResizeBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
+	if d.Get("stop_instance_before_detaching").(bool) {
+		log.Printf("[INFO] Stopping Instance %s for detaching block storage", serverInstanceNo)
+		if err := stopThenWaitServerInstance(config, serverInstanceNo); err != nil {
+			return err
+		}
+	}
+
+	if err := detachBlockStorage(config, d.Id()); err != nil {
+		return err
+	}
+
+	if err := detachThenWaitServerInstance(config, serverInstanceNo); err != nil {
+		return err
+	}
+
+	if err := resizeBlockStorage(config, d.Id(), BlockStorageHypervisorTypeXen, "", d.Get("size").(int), false, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return attachBlockStorage(d, config)
+}
+
+// resizeBlockStorage changes the size (GB) of the block storage. XEN block storage can only be resized while the
+// attached server is stopped, so a running server is stopped when stopServer is set, and started again afterwards
+// unless keepStopped is set. A server which is already stopped is kept stopped.
+func resizeBlockStorage(config *conn.ProviderConfig, id, hypervisorType, serverInstanceNo string, size int, stopServer, keepStopped bool, timeout time.Duration) error {
+	if hypervisorType != BlockStorageHypervisorTypeXen {
+		if err := changeVpcBlockStorageInstance(config, id, size); err != nil {
+			return err
+		}
+
+		return waitForBlockStorageSizeChanged(config, id, size, timeout)
+	}
+
+	restartServer := false
+
+	if len(serverInstanceNo) > 0 {
+		serverInstance, err := GetServerInstance(config, serverInstanceNo)
+		if err != nil {
+			return err
+		}
+
+		if serverInstance != nil && ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+			if !stopServer {
+				return fmt.Errorf("server instance(%s) must be stopped to resize %s block storage. Set stop_instance_before_resizing to true to stop and start it automatically", serverInstanceNo, BlockStorageHypervisorTypeXen)
+			}
+
+			log.Printf("[INFO] Stopping Instance %s for resizing block storage", serverInstanceNo)
+			if err := stopThenWaitServerInstance(config, serverInstanceNo); err != nil {
+				return err
+			}
+			restartServer = !keepStopped
+		}
+	}
+
+	if err := changeVpcBlockStorageVolumeSize(config, id, size); err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageOperationIsNull(config, d.Id()); err != nil {
+	if err := waitForBlockStorageSizeChanged(config, id, size, timeout); err != nil {
 		return err
 	}
 
+	if restartServer {
+		log.Printf("[INFO] Start Instance %s after resizing block storage", serverInstanceNo)
+		if err := startThenWaitServerInstance(config, serverInstanceNo); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func changeVpcBlockStorageVolumeSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageVolumeSize(config *conn.ProviderConfig, id string, size int) error {
 	reqParams := &vserver.ChangeBlockStorageVolumeSizeRequest{
 		RegionCode:             &config.RegionCode,
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
+		BlockStorageInstanceNo: ncloud.String(id),
+		BlockStorageSize:       ncloud.Int32(int32(size)),
 	}
 
 	LogCommonRequest("changeVpcBlockStorageVolumeSize", reqParams)
@@ -672,11 +727,11 @@ func changeVpcBlockStorageVolumeSize(d *schema.ResourceData, config *conn.Provid
 	return nil
 }
 
-func changeVpcBlockStorageInstance(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func changeVpcBlockStorageInstance(config *conn.ProviderConfig, id string, size int) error {
 	reqParams := &vserver.ChangeBlockStorageInstanceRequest{
 		RegionCode:             &config.RegionCode,
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
+		BlockStorageInstanceNo: ncloud.String(id),
+		BlockStorageSize:       ncloud.Int32(int32(size)),
 	}
 
 	LogCommonRequest("changeVpcBlockStorageInstance", reqParams)
@@ -690,29 +745,39 @@ func changeVpcBlockStorageInstance(d *schema.ResourceData, config *conn.Provider
 	return nil
 }
 
-func waitForBlockStorageOperationIsNull(config *conn.ProviderConfig, id string) error {
+// waitForBlockStorageSizeChanged waits until the change operation is finished and the new size (GB) is reflected.
+func waitForBlockStorageSizeChanged(config *conn.ProviderConfig, no string, size int, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{"CHNG"},
-		Target:  []string{"NULL"},
+		Pending: []string{BlockStorageOperationCodeChange, BlockStorageStatusNameOptimizing},
+		Target:  []string{BlockStorageStatusNameAttach, BlockStorageStatusNameDetach},
 		Refresh: func() (interface{}, string, error) {
-			instance, err := GetBlockStorage(config, id)
+			resp, err := GetBlockStorage(config, no)
 			if err != nil {
 				return 0, "", err
 			}
 
-			if instance == nil {
-				return 0, "", fmt.Errorf("fail to get BlockStorage instance, %s doesn't exist", id)
+			if resp == nil {
+				return 0, "", fmt.Errorf("fail to get BlockStorage instance, %s doesn't exist", no)
+			}
+
+			if ncloud.StringValue(resp.Operation) != BlockStorageOperationCodeNull || ncloud.Int64Value(resp.BlockStorageSize) != int64(size) {
+				return resp, BlockStorageOperationCodeChange, nil
+			}
+
+			switch statusName := ncloud.StringValue(resp.StatusName); statusName {
+			case BlockStorageStatusNameOptimizing, BlockStorageStatusNameAttach, BlockStorageStatusNameDetach:
+				return resp, statusName, nil
 			}
-			return instance, ncloud.StringValue(instance.Operation), nil
+
+			return 0, "", fmt.Errorf("error occurred while waiting to resize")
 		},
-		Timeout:    conn.DefaultUpdateTimeout,
+		Timeout:    timeout,
 		Delay:      2 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for BlockStorageInstance operation to be \"NULL\": %s", err)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for BlockStorage (%s) to be resized: %s", no, err)
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package server_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -47,7 +48,7 @@ func TestAccResourceNcloudBlockStorage_vpc_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"stop_instance_before_detaching"},
+				ImportStateVerifyIgnore: []string{"stop_instance_before_detaching", "stop_instance_before_resizing"},
 			},
 		},
 	})
@@ -84,7 +85,7 @@ func TestAccResourceNcloudBlockStorage_vpc_kvm(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"stop_instance_before_detaching"},
+				ImportStateVerifyIgnore: []string{"stop_instance_before_detaching", "stop_instance_before_resizing"},
 			},
 		},
 	})
@@ -159,6 +160,35 @@ func TestAccResourceNcloudBlockStorage_vpc_size(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudBlockStorage_vpc_sizeStopInstance(t *testing.T) {
+	var storageInstance server.BlockStorage
+	name := fmt.Sprintf("tf-storage-resize-%s", acctest.RandString(5))
+	resourceName := "ncloud_block_storage.storage"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBlockStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageVpcConfigWithSizeStopInstance(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "size", "10"),
+				),
+			},
+			{
+				Config: testAccBlockStorageVpcConfigWithSizeStopInstance(name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider(resourceName, &storageInstance, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ATTAC"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageExistsWithProvider(n string, i *server.BlockStorage, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -258,6 +288,12 @@ resource "ncloud_block_storage" "storage" {
 `, name, size)
 }
 
+func testAccBlockStorageVpcConfigWithSizeStopInstance(name string, size int) string {
+	return strings.Replace(testAccBlockStorageVpcConfigWithSize(name, size), `volume_type = "SSD"`,
+		`volume_type = "SSD"
+	stop_instance_before_resizing = true`, 1)
+}
+
 func testAccBlockStorageVpcConfig(name string) string {
 	return testAccBlockStorageVpcConfigWithSize(name, 10)
 }
@@ -55,6 +55,14 @@ func ResourceNcloudNetworkInterface() *schema.Resource {
 				ForceNew:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
 			},
+			"secondary_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+				},
+			},
 			"access_control_groups": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -135,6 +143,7 @@ func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 	d.Set("description", instance.NetworkInterfaceDescription)
 	d.Set("subnet_no", instance.SubnetNo)
 	d.Set("private_ip", instance.Ip)
+	d.Set("secondary_ips", instance.SecondaryIpList)
 	d.Set("server_instance_no", instance.InstanceNo)
 	d.Set("status", instance.NetworkInterfaceStatus.Code)
 	d.Set("access_control_groups", instance.AccessControlGroupNoList)
@@ -165,6 +174,28 @@ func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("secondary_ips") {
+		o, n := d.GetChange("secondary_ips")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		unassignIpList := ExpandStringInterfaceList(os.Difference(ns).List())
+		assignIpList := ExpandStringInterfaceList(ns.Difference(os).List())
+
+		// First unassign IPs so that an IP can be moved within the same apply.
+		if len(unassignIpList) > 0 {
+			if err := unassignSecondaryIps(d, config, unassignIpList); err != nil {
+				return err
+			}
+		}
+
+		if len(assignIpList) > 0 {
+			if err := assignSecondaryIps(d, config, assignIpList); err != nil {
+				return err
+			}
+		}
+	}
+
 	if d.HasChange("access_control_groups") {
 		o, n := d.GetChange("access_control_groups")
 		os := o.(*schema.Set)
@@ -258,6 +289,50 @@ func addNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *conn.
 	return nil
 }
 
+func assignSecondaryIps(d *schema.ResourceData, config *conn.ProviderConfig, secondaryIpList []*string) error {
+	reqParams := &vserver.AssignSecondaryIpsRequest{
+		RegionCode:         &config.RegionCode,
+		NetworkInterfaceNo: ncloud.String(d.Id()),
+		SecondaryIpList:    secondaryIpList,
+	}
+
+	LogCommonRequest("AssignSecondaryIps", reqParams)
+	resp, err := config.Client.Vserver.V2Api.AssignSecondaryIps(reqParams)
+	if err != nil {
+		LogErrorResponse("AssignSecondaryIps", err, reqParams)
+		return err
+	}
+	LogResponse("AssignSecondaryIps", resp)
+
+	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func unassignSecondaryIps(d *schema.ResourceData, config *conn.ProviderConfig, secondaryIpList []*string) error {
+	reqParams := &vserver.UnassignSecondaryIpsRequest{
+		RegionCode:         &config.RegionCode,
+		NetworkInterfaceNo: ncloud.String(d.Id()),
+		SecondaryIpList:    secondaryIpList,
+	}
+
+	LogCommonRequest("UnassignSecondaryIps", reqParams)
+	resp, err := config.Client.Vserver.V2Api.UnassignSecondaryIps(reqParams)
+	if err != nil {
+		LogErrorResponse("UnassignSecondaryIps", err, reqParams)
+		return err
+	}
+	LogResponse("UnassignSecondaryIps", resp)
+
+	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func resourceNcloudNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
@@ -310,6 +385,10 @@ func createNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig)
 		Ip:                          StringPtrOrNil(d.GetOk("private_ip")),
 	}
 
+	if v, ok := d.GetOk("secondary_ips"); ok {
+		reqParams.SecondaryIpList = ExpandStringInterfaceList(v.(*schema.Set).List())
+	}
+
 	LogCommonRequest("createVpcNetworkInterface", reqParams)
 	resp, err := config.Client.Vserver.V2Api.CreateNetworkInterface(reqParams)
 	if err != nil {
@@ -343,7 +422,7 @@ func DeleteNetworkInterface(config *conn.ProviderConfig, id string) error {
 }
 
 func attachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	err := attachVpcNetworkInterface(d, config)
+	err := attachVpcNetworkInterface(config, d.Id(), d.Get("subnet_no").(string), d.Get("server_instance_no").(string))
 	if err != nil {
 		return err
 	}
@@ -353,24 +432,24 @@ func attachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig)
 	return nil
 }
 
-func attachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachVpcNetworkInterface(config *conn.ProviderConfig, id, subnetNo, serverInstanceNo string) error {
 	reqParams := &vserver.AttachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
-		NetworkInterfaceNo: ncloud.String(d.Id()),
-		SubnetNo:           ncloud.String(d.Get("subnet_no").(string)),
-		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
+		NetworkInterfaceNo: ncloud.String(id),
+		SubnetNo:           ncloud.String(subnetNo),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
 	LogCommonRequest("attachVpcNetworkInterface", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.AttachNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("attachVpcNetworkInterface", err, d.Id())
+		LogErrorResponse("attachVpcNetworkInterface", err, id)
 		return err
 	}
 	LogCommonResponse("attachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
+	if err := waitForNetworkInterfaceAttachment(config, id); err != nil {
 		return err
 	}
 
@@ -402,7 +481,7 @@ func waitForPublicIpDisassociate(d *schema.ResourceData, config *conn.ProviderCo
 }
 
 func detachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
-	err := detachVpcNetworkInterface(d, config, serverInstanceNo)
+	err := detachVpcNetworkInterface(config, d.Id(), d.Get("subnet_no").(string), serverInstanceNo)
 	if err != nil {
 		return err
 	}
@@ -410,11 +489,11 @@ func detachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig,
 	return nil
 }
 
-func detachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
+func detachVpcNetworkInterface(config *conn.ProviderConfig, id, subnetNo, serverInstanceNo string) error {
 	reqParams := &vserver.DetachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
-		NetworkInterfaceNo: ncloud.String(d.Id()),
-		SubnetNo:           ncloud.String(d.Get("subnet_no").(string)),
+		NetworkInterfaceNo: ncloud.String(id),
+		SubnetNo:           ncloud.String(subnetNo),
 		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
@@ -422,12 +501,12 @@ func detachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConf
 
 	resp, err := config.Client.Vserver.V2Api.DetachNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("detachVpcNetworkInterface", err, d.Id())
+		LogErrorResponse("detachVpcNetworkInterface", err, id)
 		return err
 	}
 	LogCommonResponse("detachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
 		return err
 	}
 
@@ -87,6 +87,10 @@ func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *conn.Pro
 			"server_instance_no":   StringOrEmpty(r.InstanceNo),
 		}
 
+		if r.SecondaryIpList != nil {
+			instance["secondary_ips"] = StringPtrArrToStringArr(r.SecondaryIpList)
+		}
+
 		if r.AccessControlGroupNoList != nil {
 			instance["access_control_groups"] = StringPtrArrToStringArr(r.AccessControlGroupNoList)
 		}
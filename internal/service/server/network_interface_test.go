@@ -79,6 +79,45 @@ func TestAccresourceNcloudNetworkInterface_update(t *testing.T) {
 	})
 }
 
+func TestAccresourceNcloudNetworkInterface_secondaryIps(t *testing.T) {
+	var networkInterface vserver.NetworkInterface
+	resourceName := "ncloud_network_interface.foo"
+	name := fmt.Sprintf("tf-nic-secondary-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNetworkInterfaceSecondaryIps(name, `"10.4.0.7", "10.4.0.8"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfaceExists(resourceName, &networkInterface),
+					resource.TestCheckResourceAttr(resourceName, "secondary_ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_ips.*", "10.4.0.7"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_ips.*", "10.4.0.8"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNetworkInterfaceSecondaryIps(name, `"10.4.0.8", "10.4.0.9"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfaceExists(resourceName, &networkInterface),
+					resource.TestCheckResourceAttr(resourceName, "secondary_ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_ips.*", "10.4.0.8"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_ips.*", "10.4.0.9"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNetworkInterfaceSecondaryIps(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfaceExists(resourceName, &networkInterface),
+					resource.TestCheckResourceAttr(resourceName, "secondary_ips.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccresourceNcloudNetworkInterface_disappears(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
 	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
@@ -128,6 +167,33 @@ resource "ncloud_network_interface" "foo" {
 `, name)
 }
 
+func testAccResourceNcloudNetworkInterfaceSecondaryIps(name, secondaryIps string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.4.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.4.0.0/24"
+	zone               = "KR-1"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_network_interface" "foo" {
+	name                  = "%[1]s"
+	subnet_no             = ncloud_subnet.test.id
+	private_ip            = "10.4.0.6"
+	secondary_ips         = [%[2]s]
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+}
+`, name, secondaryIps)
+}
+
 func testAccResourceNcloudNetworkInterfaceUpdate(name, instanceNo string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "test" {
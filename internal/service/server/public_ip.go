@@ -1,12 +1,14 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,10 +27,13 @@ func ResourceNcloudPublicIpInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: disassociatePublicIpIfEmptyDiff,
 		Schema: map[string]*schema.Schema{
+			// Computed, so that association made by ncloud_public_ip_association is not reverted when omitted.
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"description": {
 				Type:             schema.TypeString,
@@ -67,6 +72,30 @@ func ResourceNcloudPublicIpInstance() *schema.Resource {
 	}
 }
 
+// disassociatePublicIpIfEmptyDiff plans disassociation for server_instance_no explicitly set to empty string,
+// which is not distinguished from omitted one for computed attribute otherwise.
+func disassociatePublicIpIfEmptyDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !isServerInstanceNoConfiguredEmpty(d.GetRawConfig()) {
+		return nil
+	}
+
+	if o, _ := d.GetChange("server_instance_no"); o.(string) != "" {
+		return d.SetNew("server_instance_no", "")
+	}
+
+	return nil
+}
+
+func isServerInstanceNoConfiguredEmpty(raw cty.Value) bool {
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+
+	v := raw.GetAttr("server_instance_no")
+
+	return v.IsKnown() && !v.IsNull() && v.AsString() == ""
+}
+
 func resourceNcloudPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 	var publicIpInstanceNo *string
@@ -141,8 +170,13 @@ func resourceNcloudPublicIpDelete(d *schema.ResourceData, meta interface{}) erro
 func resourceNcloudPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	if d.HasChange("server_instance_no") {
+	// Planned empty server_instance_no is dropped from the diff on apply, so it is checked from the config again.
+	if d.HasChange("server_instance_no") || isServerInstanceNoConfiguredEmpty(d.GetRawConfig()) {
 		o, n := d.GetChange("server_instance_no")
+		if isServerInstanceNoConfiguredEmpty(d.GetRawConfig()) {
+			n = ""
+		}
+
 		if len(o.(string)) > 0 {
 			if err := disassociatedPublicIp(config, d.Id()); err != nil {
 				return err
@@ -150,20 +184,9 @@ func resourceNcloudPublicIpUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		if len(n.(string)) > 0 {
-			if err := resource.Retry(time.Minute, func() *resource.RetryError {
-				if err := associatedPublicIp(d, config); err != nil {
-					errBody, _ := GetCommonErrorBody(err)
-					if errBody.ReturnCode == "1003016" {
-						time.Sleep(time.Second * 1)
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			}); err != nil {
+			if err := associatedPublicIp(config, d.Id(), n.(string)); err != nil {
 				return err
 			}
-
 		}
 	}
 
@@ -369,31 +392,40 @@ func waitForPublicIpAssociation(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
-func associatedPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	err := associatedVpcPublicIp(d, config)
-	if err != nil {
+func associatedPublicIp(config *conn.ProviderConfig, id, serverInstanceNo string) error {
+	if err := resource.Retry(time.Minute, func() *resource.RetryError {
+		if err := associatedVpcPublicIp(config, id, serverInstanceNo); err != nil {
+			errBody, _ := GetCommonErrorBody(err)
+			if errBody.ReturnCode == "1003016" {
+				time.Sleep(time.Second * 1)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	}); err != nil {
 		return err
 	}
 
-	if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
+	if err := waitForPublicIpAssociation(config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func associatedVpcPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func associatedVpcPublicIp(config *conn.ProviderConfig, id, serverInstanceNo string) error {
 	reqParams := &vserver.AssociatePublicIpWithServerInstanceRequest{
 		RegionCode:         &config.RegionCode,
-		PublicIpInstanceNo: ncloud.String(d.Id()),
-		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
+		PublicIpInstanceNo: ncloud.String(id),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
 	LogCommonRequest("associatedVpcPublicIp", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.AssociatePublicIpWithServerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("associatedVpcPublicIp", err, d.Id())
+		LogErrorResponse("associatedVpcPublicIp", err, id)
 		return err
 	}
 	LogCommonResponse("associatedVpcPublicIp", GetCommonResponse(resp))
@@ -0,0 +1,227 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &publicIpAssociationResource{}
+	_ resource.ResourceWithConfigure   = &publicIpAssociationResource{}
+	_ resource.ResourceWithImportState = &publicIpAssociationResource{}
+)
+
+func NewPublicIpAssociationResource() resource.Resource {
+	return &publicIpAssociationResource{}
+}
+
+type publicIpAssociationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (p *publicIpAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("public_ip_no"), req, resp)
+}
+
+func (p *publicIpAssociationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_ip_association"
+}
+
+func (p *publicIpAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"public_ip_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_instance_no": schema.StringAttribute{
+				Required: true,
+			},
+			"public_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (p *publicIpAssociationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.config = config
+}
+
+func (p *publicIpAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan publicIpAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.PublicIpNo.ValueString()
+
+	// Public IP associated with another server is moved, same as on update.
+	if err := movePublicIp(p.config, id, plan.ServerInstanceNo.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := GetPublicIp(p.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("not found public ip(%s)", id))
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *publicIpAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state publicIpAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetPublicIp(p.config, state.PublicIpNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil || ncloud.StringValue(output.ServerInstanceNo) == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (p *publicIpAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan publicIpAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.PublicIpNo.ValueString()
+
+	if err := movePublicIp(p.config, id, plan.ServerInstanceNo.ValueString()); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	output, err := GetPublicIp(p.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("not found public ip(%s)", id))
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *publicIpAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state publicIpAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetPublicIp(p.config, state.PublicIpNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	// Keep the public ip associated, when it is already moved to another server outside of this resource.
+	if output == nil || ncloud.StringValue(output.ServerInstanceNo) != state.ServerInstanceNo.ValueString() {
+		return
+	}
+
+	if err := disassociatedPublicIp(p.config, state.PublicIpNo.ValueString()); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+	}
+}
+
+// movePublicIp associates the public ip with the server, disassociating it from the current server first if any.
+func movePublicIp(config *conn.ProviderConfig, id, serverInstanceNo string) error {
+	output, err := GetPublicIp(config, id)
+	if err != nil {
+		return err
+	}
+
+	if output == nil {
+		return fmt.Errorf("not found public ip(%s)", id)
+	}
+
+	current := ncloud.StringValue(output.ServerInstanceNo)
+	if current == serverInstanceNo {
+		return nil
+	}
+
+	if current != "" {
+		if err := disassociatedPublicIp(config, id); err != nil {
+			return err
+		}
+	}
+
+	return associatedPublicIp(config, id, serverInstanceNo)
+}
+
+type publicIpAssociationResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	PublicIpNo       types.String `tfsdk:"public_ip_no"`
+	ServerInstanceNo types.String `tfsdk:"server_instance_no"`
+	PublicIp         types.String `tfsdk:"public_ip"`
+	PrivateIp        types.String `tfsdk:"private_ip"`
+}
+
+func (m *publicIpAssociationResourceModel) refreshFromOutput(output *PublicIpInstance) {
+	m.ID = types.StringPointerValue(output.PublicIpInstanceNo)
+	m.PublicIpNo = types.StringPointerValue(output.PublicIpInstanceNo)
+	m.ServerInstanceNo = types.StringPointerValue(output.ServerInstanceNo)
+	m.PublicIp = types.StringPointerValue(output.PublicIp)
+	m.PrivateIp = types.StringPointerValue(output.PrivateIp)
+}
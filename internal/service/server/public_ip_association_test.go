@@ -0,0 +1,165 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudPublicIpAssociation_basic(t *testing.T) {
+	serverNameFoo := fmt.Sprintf("tf-ip-assoc-foo-%s", acctest.RandString(5))
+	serverNameBar := fmt.Sprintf("tf-ip-assoc-bar-%s", acctest.RandString(5))
+	resourceName := "ncloud_public_ip_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPublicIpAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicIpAssociationConfig(serverNameFoo, serverNameBar, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip_no", "ncloud_public_ip.public_ip", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "ncloud_public_ip.public_ip", "public_ip"),
+				),
+			},
+			{
+				Config: testAccPublicIpAssociationConfig(serverNameFoo, serverNameBar, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.bar", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "ncloud_public_ip.public_ip", "public_ip"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceNcloudPublicIpAssociation_fixture_move(t *testing.T) {
+	gw := NewFakeAPIGateway(t, "public_ip_association_basic")
+	resourceName := "ncloud_public_ip_association.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if n := gw.Requests("/vserver/v2/associatePublicIpWithServerInstance"); n != 2 {
+				return fmt.Errorf("expected 2 associatePublicIpWithServerInstance requests, got %d", n)
+			}
+			if n := gw.Requests("/vserver/v2/disassociatePublicIpFromServerInstance"); n != 2 {
+				return fmt.Errorf("expected 2 disassociatePublicIpFromServerInstance requests, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "ncloud_public_ip_association" "test" {
+	public_ip_no       = "1112223"
+	server_instance_no = "1000001"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1112223"),
+					resource.TestCheckResourceAttr(resourceName, "server_instance_no", "1000001"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip", "223.130.0.10"),
+					resource.TestCheckResourceAttr(resourceName, "private_ip", "10.0.1.6"),
+				),
+			},
+			{
+				Config: `
+resource "ncloud_public_ip_association" "test" {
+	public_ip_no       = "1112223"
+	server_instance_no = "1000002"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1112223"),
+					resource.TestCheckResourceAttr(resourceName, "server_instance_no", "1000002"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip", "223.130.0.10"),
+					resource.TestCheckResourceAttr(resourceName, "private_ip", "10.0.1.7"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicIpAssociationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_public_ip_association" {
+			continue
+		}
+
+		instance, err := server.GetPublicIp(config, rs.Primary.Attributes["public_ip_no"])
+		if err != nil {
+			return err
+		}
+
+		if instance != nil && instance.ServerInstanceNo != nil && *instance.ServerInstanceNo != "" {
+			return fmt.Errorf("public ip (%s) is still associated with server (%s)", rs.Primary.ID, *instance.ServerInstanceNo)
+		}
+	}
+
+	return nil
+}
+
+func testAccPublicIpAssociationConfig(serverNameFoo, serverNameBar, target string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "foo" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_server" "bar" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[2]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_public_ip" "public_ip" {
+	description = "%[1]s"
+}
+
+resource "ncloud_public_ip_association" "test" {
+	public_ip_no       = ncloud_public_ip.public_ip.id
+	server_instance_no = ncloud_server.%[3]s.id
+}
+`, serverNameFoo, serverNameBar, target)
+}
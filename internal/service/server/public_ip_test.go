@@ -82,6 +82,47 @@ func TestAccResourceNcloudPublicIpInstance_vpc_updateServerInstanceNo(t *testing
 	})
 }
 
+func TestResourceNcloudPublicIpInstance_fixture_serverInstanceNo(t *testing.T) {
+	gw := NewFakeAPIGateway(t, "public_ip_basic")
+	resourceName := "ncloud_public_ip.public_ip"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			// only the explicit empty server_instance_no disassociates
+			if n := gw.Requests("/vserver/v2/disassociatePublicIpFromServerInstance"); n != 1 {
+				return fmt.Errorf("expected 1 disassociatePublicIpFromServerInstance request, got %d", n)
+			}
+			if n := gw.Requests("/vserver/v2/deletePublicIpInstance"); n != 1 {
+				return fmt.Errorf("expected 1 deletePublicIpInstance request, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicIpInstanceFixtureConfig(`server_instance_no = "1000001"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1112223"),
+					resource.TestCheckResourceAttr(resourceName, "server_instance_no", "1000001"),
+				),
+			},
+			{
+				// Omitted server_instance_no keeps the association, e.g. managed by ncloud_public_ip_association.
+				Config: testAccPublicIpInstanceFixtureConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_instance_no", "1000001"),
+				),
+			},
+			{
+				Config: testAccPublicIpInstanceFixtureConfig(`server_instance_no = ""`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_instance_no", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPublicIpInstanceExists(n string, i *server.PublicIpInstance, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -227,3 +268,12 @@ resource "ncloud_public_ip" "public_ip" {
 }
 `, serverNameFoo, serverNameBar, serverInstanceNo)
 }
+
+func testAccPublicIpInstanceFixtureConfig(serverInstanceNo string) string {
+	return fmt.Sprintf(`
+resource "ncloud_public_ip" "public_ip" {
+	description = "egress"
+	%s
+}
+`, serverInstanceNo)
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +24,11 @@ import (
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 )
 
+const (
+	ServerDesiredStateRunning = "running"
+	ServerDesiredStateStopped = "stopped"
+)
+
 func ResourceNcloudServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNcloudServerCreate,
@@ -33,8 +40,22 @@ func ResourceNcloudServer() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(6 * conn.DefaultTimeout),
 			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ValidateChange("base_block_storage_size", func(ctx context.Context, old, new, meta interface{}) error {
+				if old.(int) > 0 && new.(int) < old.(int) {
+					return fmt.Errorf("base_block_storage_size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", new, old)
+				}
+				return nil
+			}),
+			// Additional network interfaces are attached and detached in place, but the primary one can't be replaced.
+			customdiff.ForceNewIfChange("network_interface", func(ctx context.Context, old, new, meta interface{}) bool {
+				return isNetworkInterfaceOrderChanged(old.([]interface{}), new.([]interface{}))
+			}),
+			validateServerSpecDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"server_image_product_code": {
 				Type:          schema.TypeString,
@@ -93,6 +114,12 @@ func ResourceNcloudServer() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"desired_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{ServerDesiredStateRunning, ServerDesiredStateStopped}, false)),
+			},
 			"fee_system_type_code": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -154,12 +181,10 @@ func ResourceNcloudServer() *schema.Resource {
 						"network_interface_no": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"order": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"subnet_no": {
 							Type:     schema.TypeString,
@@ -195,8 +220,10 @@ func ResourceNcloudServer() *schema.Resource {
 				Computed: true,
 			},
 			"base_block_storage_size": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateBaseBlockStorageSize),
 			},
 			"platform_type": {
 				Type:     schema.TypeString,
@@ -264,6 +291,13 @@ func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Server instance ID: %s", d.Id())
 
+	if d.Get("desired_state").(string) == ServerDesiredStateStopped {
+		log.Printf("[INFO] Stopping Instance %q for desired_state", d.Id())
+		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudServerRead(d, meta)
 }
 
@@ -285,6 +319,14 @@ func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 
 	SetSingularResourceDataFromMapSchema(ResourceNcloudServer(), d, instance)
 
+	// Keep desired_state while server is in transition, e.g. booting or being stopped.
+	switch ncloud.StringValue(r.ServerInstanceStatus) {
+	case "RUN":
+		d.Set("desired_state", ServerDesiredStateRunning)
+	case "NSTOP":
+		d.Set("desired_state", ServerDesiredStateStopped)
+	}
+
 	return nil
 }
 
@@ -344,12 +386,30 @@ func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("network_interface") {
+		if err := updateServerNetworkInterface(d, config); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("base_block_storage_size") {
+		if err := changeServerBaseBlockStorageSize(d, config); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("is_protect_server_termination") {
 		if err := updateServerProtectionTermination(d, config); err != nil {
 			return err
 		}
 	}
 
+	if d.HasChange("desired_state") {
+		if err := updateServerDesiredState(d, config); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudServerRead(d, meta)
 }
 
@@ -424,6 +484,15 @@ func createServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (
 		}
 	}
 
+	if size, ok := d.GetOk("base_block_storage_size"); ok {
+		reqParams.BlockStorageMappingList = []*vserver.BlockStorageMappingParameter{
+			{
+				Order:            ncloud.Int32(0),
+				BlockStorageSize: ncloud.String(strconv.Itoa(size.(int) / GIGABYTE)),
+			},
+		}
+	}
+
 	if blockDevicePartitionList, err := expandBlockDevicePartitionListParams(d.Get("block_device_partition_list").([]interface{})); err == nil {
 		reqParams.BlockDevicePartitionList = blockDevicePartitionList
 	}
@@ -507,6 +576,10 @@ func updateServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 		return err
 	}
 
+	if d.Get("desired_state").(string) == ServerDesiredStateStopped {
+		return nil
+	}
+
 	log.Printf("[INFO] Start Instance %q for server_product_code change", d.Id())
 	if err := startThenWaitServerInstance(config, d.Id()); err != nil {
 		return err
@@ -515,6 +588,192 @@ func updateServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 	return nil
 }
 
+// isNetworkInterfaceOrderChanged reports whether the primary network interface is replaced or an interface kept attached moves to another order.
+func isNetworkInterfaceOrderChanged(old, new []interface{}) bool {
+	if len(old) == 0 {
+		return false
+	}
+
+	oldOrders := expandNetworkInterfaceOrders(old)
+	newOrders := expandNetworkInterfaceOrders(new)
+
+	for no, order := range oldOrders {
+		newOrder, ok := newOrders[no]
+		if order == 0 && (!ok || newOrder != 0) {
+			return true
+		}
+		if ok && newOrder != order {
+			return true
+		}
+	}
+
+	for no, order := range newOrders {
+		if _, ok := oldOrders[no]; !ok && order == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandNetworkInterfaceOrders(l []interface{}) map[string]int {
+	orders := make(map[string]int, len(l))
+	for _, v := range l {
+		if m, ok := v.(map[string]interface{}); ok {
+			orders[m["network_interface_no"].(string)] = m["order"].(int)
+		}
+	}
+	return orders
+}
+
+func updateServerNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	o, n := d.GetChange("network_interface")
+	oldOrders := expandNetworkInterfaceOrders(o.([]interface{}))
+	newOrders := expandNetworkInterfaceOrders(n.([]interface{}))
+
+	var detachList, attachList []string
+	for no := range oldOrders {
+		if _, ok := newOrders[no]; !ok {
+			detachList = append(detachList, no)
+		}
+	}
+	for no := range newOrders {
+		if _, ok := oldOrders[no]; !ok {
+			attachList = append(attachList, no)
+		}
+	}
+
+	if len(detachList) == 0 && len(attachList) == 0 {
+		return nil
+	}
+
+	serverInstance, err := GetServerInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if serverInstance == nil {
+		return fmt.Errorf("fail to get Server instance, %s doesn't exist", d.Id())
+	}
+
+	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+		log.Printf("[INFO] Stopping Instance %q for network_interface change", d.Id())
+		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	for _, no := range detachList {
+		networkInterface, err := GetNetworkInterface(config, no)
+		if err != nil {
+			return err
+		}
+
+		// Already deleted network interface is detached with it.
+		if networkInterface == nil {
+			continue
+		}
+
+		if err := detachVpcNetworkInterface(config, no, ncloud.StringValue(networkInterface.SubnetNo), d.Id()); err != nil {
+			return err
+		}
+
+		if err := detachThenWaitServerInstance(config, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	for _, no := range attachList {
+		networkInterface, err := GetNetworkInterface(config, no)
+		if err != nil {
+			return err
+		}
+
+		if networkInterface == nil {
+			return fmt.Errorf("no matching network interface [%s] found", no)
+		}
+
+		if err := attachVpcNetworkInterface(config, no, ncloud.StringValue(networkInterface.SubnetNo), d.Id()); err != nil {
+			return err
+		}
+
+		if err := detachThenWaitServerInstance(config, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("desired_state").(string) == ServerDesiredStateStopped {
+		return nil
+	}
+
+	log.Printf("[INFO] Start Instance %q for network_interface change", d.Id())
+	if err := startThenWaitServerInstance(config, d.Id()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateBaseBlockStorageSize checks the size in bytes is a whole number of gigabytes, as the API takes it in GB.
+func validateBaseBlockStorageSize(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value <= 0 || value%GIGABYTE != 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive multiple of 1 GB (%d bytes), got: %d", k, GIGABYTE, value))
+	}
+	return
+}
+
+func changeServerBaseBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	blockStorageList, err := getVpcBasicBlockStorageList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	var baseBlockStorage *BlockStorage
+	for _, blockStorage := range blockStorageList {
+		if ncloud.StringValue(blockStorage.BlockStorageType) == "BASIC" {
+			baseBlockStorage = blockStorage
+			break
+		}
+	}
+
+	if baseBlockStorage == nil {
+		return fmt.Errorf("no base block storage found for server instance(%s)", d.Id())
+	}
+
+	// The server is stopped for resizing XEN base block storage and started again unless it is to be kept stopped.
+	return resizeBlockStorage(config, *baseBlockStorage.BlockStorageInstanceNo, d.Get("hypervisor_type").(string), d.Id(),
+		d.Get("base_block_storage_size").(int)/GIGABYTE, true, d.Get("desired_state").(string) == ServerDesiredStateStopped, d.Timeout(schema.TimeoutUpdate))
+}
+
+func updateServerDesiredState(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	serverInstance, err := GetServerInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if serverInstance == nil {
+		return fmt.Errorf("fail to get Server instance, %s doesn't exist", d.Id())
+	}
+
+	status := ncloud.StringValue(serverInstance.ServerInstanceStatus)
+
+	switch d.Get("desired_state").(string) {
+	case ServerDesiredStateStopped:
+		if status != "NSTOP" {
+			log.Printf("[INFO] Stopping Instance %q for desired_state change", d.Id())
+			return stopThenWaitServerInstance(config, d.Id())
+		}
+	case ServerDesiredStateRunning:
+		if status != "RUN" {
+			log.Printf("[INFO] Start Instance %q for desired_state change", d.Id())
+			return startThenWaitServerInstance(config, d.Id())
+		}
+	}
+
+	return nil
+}
+
 func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
 	err := changeVpcServerInstanceSpec(d, config)
 	if err != nil {
@@ -0,0 +1,389 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+const (
+	ServerImageStatusCodeInit      = "INIT"
+	ServerImageStatusCodeCreate    = "CREAT"
+	ServerImageStatusCodeTerminate = "TERMT"
+)
+
+var (
+	_ resource.Resource                = &serverImageResource{}
+	_ resource.ResourceWithConfigure   = &serverImageResource{}
+	_ resource.ResourceWithImportState = &serverImageResource{}
+)
+
+func NewServerImageResource() resource.Resource {
+	return &serverImageResource{}
+}
+
+type serverImageResource struct {
+	config *conn.ProviderConfig
+}
+
+func (s *serverImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (s *serverImageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_image"
+}
+
+func (s *serverImageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"server_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					// Not returned by the API, so it is only set into state after import without replacement.
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the server instance requires replacement, unless it is not known after import.",
+						"Changing the server instance requires replacement, unless it is not known after import.",
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: verify.InstanceNameValidator(),
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+				},
+			},
+			"server_image_number": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"product_code": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hypervisor_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cpu_architecture_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"os_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (s *serverImageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	s.config = config
+}
+
+func (s *serverImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, conn.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &vserver.CreateServerImageRequest{
+		RegionCode:       &s.config.RegionCode,
+		ServerInstanceNo: plan.ServerInstanceNo.ValueStringPointer(),
+		ServerImageName:  plan.Name.ValueStringPointer(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		reqParams.ServerImageDescription = plan.Description.ValueStringPointer()
+	}
+
+	tflog.Info(ctx, "CreateServerImage", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	response, err := s.config.Client.Vserver.V2Api.CreateServerImage(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "CreateServerImage response", map[string]any{
+		"createServerImageResponse": common.MarshalUncheckedString(response),
+	})
+
+	if len(response.ServerImageList) < 1 {
+		resp.Diagnostics.AddError("CREATING ERROR", "no server image in response")
+		return
+	}
+
+	id := ncloud.StringValue(response.ServerImageList[0].ServerImageNo)
+	plan.ID = types.StringValue(id)
+
+	// Save id first, so that image failed to be created is still tracked and can be destroyed.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := waitForServerImageCreation(ctx, s.config, id, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR CREATION ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (s *serverImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverImageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetServerImage(ctx, s.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil || ncloud.StringValue(common.GetCodePtrByCommonCode(output.ServerImageStatus)) == ServerImageStatusCodeTerminate {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (s *serverImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (s *serverImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverImageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, conn.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &vserver.DeleteServerImageRequest{
+		RegionCode:        &s.config.RegionCode,
+		ServerImageNoList: []*string{state.ID.ValueStringPointer()},
+	}
+
+	tflog.Info(ctx, "DeleteServerImage", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	response, err := s.config.Client.Vserver.V2Api.DeleteServerImage(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DeleteServerImage response", map[string]any{
+		"deleteServerImageResponse": common.MarshalUncheckedString(response),
+	})
+
+	if err := waitForServerImageDeletion(ctx, s.config, state.ID.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
+	}
+}
+
+func GetServerImage(ctx context.Context, config *conn.ProviderConfig, id string) (*vserver.ServerImage, error) {
+	reqParams := &vserver.GetServerImageDetailRequest{
+		RegionCode:    &config.RegionCode,
+		ServerImageNo: ncloud.String(id),
+	}
+
+	tflog.Info(ctx, "GetServerImageDetail", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+
+	resp, err := config.Client.Vserver.V2Api.GetServerImageDetail(reqParams)
+	if err != nil {
+		tflog.Error(ctx, "GetServerImageDetail", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+			"error":     err,
+		})
+		return nil, err
+	}
+	tflog.Info(ctx, "GetServerImageDetail", map[string]any{
+		"resp": common.MarshalUncheckedString(resp),
+	})
+
+	if len(resp.ServerImageList) > 0 {
+		return resp.ServerImageList[0], nil
+	}
+
+	return nil, nil
+}
+
+func waitForServerImageCreation(ctx context.Context, config *conn.ProviderConfig, id string, timeout time.Duration) (*vserver.ServerImage, error) {
+	var serverImage *vserver.ServerImage
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ServerImageStatusCodeInit},
+		Target:  []string{ServerImageStatusCodeCreate},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := GetServerImage(ctx, config, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if resp == nil {
+				return nil, "", fmt.Errorf("not found server image(%s)", id)
+			}
+
+			serverImage = resp
+			return resp, ncloud.StringValue(common.GetCodePtrByCommonCode(resp.ServerImageStatus)), nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return nil, fmt.Errorf("error waiting for server image (%s) to become available: %s", id, err)
+	}
+
+	return serverImage, nil
+}
+
+func waitForServerImageDeletion(ctx context.Context, config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ServerImageStatusCodeInit, ServerImageStatusCodeCreate},
+		Target:  []string{ServerImageStatusCodeTerminate},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := GetServerImage(ctx, config, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if resp == nil {
+				return id, ServerImageStatusCodeTerminate, nil
+			}
+
+			return resp, ncloud.StringValue(common.GetCodePtrByCommonCode(resp.ServerImageStatus)), nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for server image (%s) to be deleted: %s", id, err)
+	}
+
+	return nil
+}
+
+type serverImageResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	ServerInstanceNo    types.String   `tfsdk:"server_instance_no"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	ServerImageNumber   types.String   `tfsdk:"server_image_number"`
+	ProductCode         types.String   `tfsdk:"product_code"`
+	Type                types.String   `tfsdk:"type"`
+	HypervisorType      types.String   `tfsdk:"hypervisor_type"`
+	CpuArchitectureType types.String   `tfsdk:"cpu_architecture_type"`
+	OsType              types.String   `tfsdk:"os_type"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *serverImageResourceModel) refreshFromOutput(output *vserver.ServerImage) {
+	m.ID = types.StringPointerValue(output.ServerImageNo)
+	m.ServerImageNumber = types.StringPointerValue(output.ServerImageNo)
+	m.Name = types.StringPointerValue(output.ServerImageName)
+	m.Description = framework.EmptyStringToNull(types.StringPointerValue(output.ServerImageDescription))
+	m.ProductCode = types.StringPointerValue(output.ServerImageProductCode)
+	m.Type = types.StringPointerValue(common.GetCodePtrByCommonCode(output.ServerImageType))
+	m.HypervisorType = types.StringPointerValue(common.GetCodePtrByCommonCode(output.HypervisorType))
+	m.CpuArchitectureType = types.StringPointerValue(common.GetCodePtrByCommonCode(output.CpuArchitectureType))
+	m.OsType = types.StringPointerValue(common.GetCodePtrByCommonCode(output.OsType))
+}
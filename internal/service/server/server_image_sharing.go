@@ -0,0 +1,333 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &serverImageSharingResource{}
+	_ resource.ResourceWithConfigure   = &serverImageSharingResource{}
+	_ resource.ResourceWithImportState = &serverImageSharingResource{}
+)
+
+func NewServerImageSharingResource() resource.Resource {
+	return &serverImageSharingResource{}
+}
+
+type serverImageSharingResource struct {
+	config *conn.ProviderConfig
+}
+
+func (s *serverImageSharingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("server_image_number"), req, resp)
+}
+
+func (s *serverImageSharingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_image_sharing"
+}
+
+func (s *serverImageSharingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"server_image_number": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_login_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"share_status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *serverImageSharingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	s.config = config
+}
+
+func (s *serverImageSharingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverImageSharingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loginIds []string
+	resp.Diagnostics.Append(plan.TargetLoginIds.ElementsAs(ctx, &loginIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := addServerImageSharingPermission(ctx, s.config, plan.ServerImageNumber.ValueString(), loginIds); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	output, err := GetServerImage(ctx, s.config, plan.ServerImageNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("not found server image(%s)", plan.ServerImageNumber.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (s *serverImageSharingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverImageSharingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loginIds []string
+	resp.Diagnostics.Append(state.TargetLoginIds.ElementsAs(ctx, &loginIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetServerImage(ctx, s.config, state.ServerImageNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	// Sharing is gone when none of the managed login ids is shared anymore.
+	// On import there is no login id in state yet, so any shared one is adopted.
+	if output == nil || !isAnyLoginIdShared(output.SharedLoginIdList, loginIds) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (s *serverImageSharingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serverImageSharingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planLoginIds, stateLoginIds []string
+	resp.Diagnostics.Append(plan.TargetLoginIds.ElementsAs(ctx, &planLoginIds, false)...)
+	resp.Diagnostics.Append(state.TargetLoginIds.ElementsAs(ctx, &stateLoginIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ServerImageNumber.ValueString()
+	added, removed := diffLoginIds(stateLoginIds, planLoginIds)
+
+	if len(removed) > 0 {
+		if err := removeServerImageSharingPermission(ctx, s.config, id, removed); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	if len(added) > 0 {
+		if err := addServerImageSharingPermission(ctx, s.config, id, added); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	output, err := GetServerImage(ctx, s.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("not found server image(%s)", id))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (s *serverImageSharingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverImageSharingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loginIds []string
+	resp.Diagnostics.Append(state.TargetLoginIds.ElementsAs(ctx, &loginIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetServerImage(ctx, s.config, state.ServerImageNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	// Server image is already deleted with its sharing permissions.
+	if output == nil {
+		return
+	}
+
+	if err := removeServerImageSharingPermission(ctx, s.config, state.ServerImageNumber.ValueString(), loginIds); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+	}
+}
+
+func addServerImageSharingPermission(ctx context.Context, config *conn.ProviderConfig, id string, loginIds []string) error {
+	reqParams := &vserver.AddServerImageSharingPermissionRequest{
+		RegionCode:        &config.RegionCode,
+		ServerImageNo:     ncloud.String(id),
+		TargetLoginIdList: ncloud.StringList(loginIds),
+	}
+
+	tflog.Info(ctx, "AddServerImageSharingPermission", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	resp, err := config.Client.Vserver.V2Api.AddServerImageSharingPermission(reqParams)
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, "AddServerImageSharingPermission response", map[string]any{
+		"resp": common.MarshalUncheckedString(resp),
+	})
+
+	return nil
+}
+
+func removeServerImageSharingPermission(ctx context.Context, config *conn.ProviderConfig, id string, loginIds []string) error {
+	reqParams := &vserver.RemoveServerImageSharingPermissionRequest{
+		RegionCode:        &config.RegionCode,
+		ServerImageNo:     ncloud.String(id),
+		TargetLoginIdList: ncloud.StringList(loginIds),
+	}
+
+	tflog.Info(ctx, "RemoveServerImageSharingPermission", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	resp, err := config.Client.Vserver.V2Api.RemoveServerImageSharingPermission(reqParams)
+	if err != nil {
+		return err
+	}
+	tflog.Info(ctx, "RemoveServerImageSharingPermission response", map[string]any{
+		"resp": common.MarshalUncheckedString(resp),
+	})
+
+	return nil
+}
+
+// isAnyLoginIdShared reports whether any of loginIds is in the shared list. Empty loginIds matches any shared login id.
+func isAnyLoginIdShared(shared []*string, loginIds []string) bool {
+	for _, v := range shared {
+		if len(loginIds) == 0 || slices.Contains(loginIds, ncloud.StringValue(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diffLoginIds returns login ids to be newly shared and to be unshared, so that the others keep their permission.
+func diffLoginIds(old, new []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(old))
+	for _, v := range old {
+		oldSet[v] = true
+	}
+
+	newSet := make(map[string]bool, len(new))
+	for _, v := range new {
+		newSet[v] = true
+		if !oldSet[v] {
+			added = append(added, v)
+		}
+	}
+
+	for _, v := range old {
+		if !newSet[v] {
+			removed = append(removed, v)
+		}
+	}
+
+	return added, removed
+}
+
+type serverImageSharingResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ServerImageNumber types.String `tfsdk:"server_image_number"`
+	TargetLoginIds    types.Set    `tfsdk:"target_login_ids"`
+	ShareStatus       types.String `tfsdk:"share_status"`
+}
+
+func (m *serverImageSharingResourceModel) refreshFromOutput(ctx context.Context, output *vserver.ServerImage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringPointerValue(output.ServerImageNo)
+	m.ServerImageNumber = types.StringPointerValue(output.ServerImageNo)
+	m.TargetLoginIds, diags = types.SetValueFrom(ctx, types.StringType, output.SharedLoginIdList)
+	m.ShareStatus = types.StringPointerValue(common.GetCodePtrByCommonCode(output.ShareStatus))
+
+	return diags
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &serverImageSharingDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverImageSharingDataSource{}
+)
+
+func NewServerImageSharingDataSource() datasource.DataSource {
+	return &serverImageSharingDataSource{}
+}
+
+type serverImageSharingDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (s *serverImageSharingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_image_sharing"
+}
+
+func (s *serverImageSharingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"server_image_number": schema.StringAttribute{
+				Required: true,
+			},
+			"target_login_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"share_status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *serverImageSharingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	s.config = config
+}
+
+func (s *serverImageSharingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverImageSharingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetServerImage(ctx, s.config, data.ServerImageNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("not found server image(%s)", data.ServerImageNumber.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
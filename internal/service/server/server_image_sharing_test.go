@@ -0,0 +1,113 @@
+package server_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudServerImageSharing_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-image-share-%s", sdkacctest.RandString(5))
+	resourceName := "ncloud_server_image_sharing.test"
+	// Target must be an existing login id of another account.
+	loginId := os.Getenv("NCLOUD_SHARING_TARGET_LOGIN_ID")
+	if loginId == "" {
+		t.Skip("NCLOUD_SHARING_TARGET_LOGIN_ID must be set for server image sharing acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudServerImageConfig(name) + fmt.Sprintf(`
+resource "ncloud_server_image_sharing" "test" {
+	server_image_number    = ncloud_server_image.test.id
+	target_login_ids       = ["%[1]s"]
+}
+`, loginId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "server_image_number", "ncloud_server_image.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "target_login_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target_login_ids.*", loginId),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceNcloudServerImageSharing_fixture_basic(t *testing.T) {
+	gw := acctest.NewFakeAPIGateway(t, "server_image_sharing_basic")
+	resourceName := "ncloud_server_image_sharing.test"
+	dataName := "data.ncloud_server_image_sharing.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if n := gw.Requests("/vserver/v2/removeServerImageSharingPermission"); n != 2 {
+				return fmt.Errorf("expected 2 removeServerImageSharingPermission requests, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerImageSharingFixtureConfig(`"dev@example.com", "stage@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "7654321"),
+					resource.TestCheckResourceAttr(resourceName, "target_login_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "share_status", "SHARE"),
+				),
+			},
+			{
+				Config: testAccServerImageSharingFixtureConfig(`"stage@example.com", "prod@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target_login_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target_login_ids.*", "prod@example.com"),
+					func(*terraform.State) error {
+						// only the changed login ids are shared and unshared
+						if n := gw.Requests("/vserver/v2/addServerImageSharingPermission"); n != 2 {
+							return fmt.Errorf("expected 2 addServerImageSharingPermission requests, got %d", n)
+						}
+						if n := gw.Requests("/vserver/v2/removeServerImageSharingPermission"); n != 1 {
+							return fmt.Errorf("expected 1 removeServerImageSharingPermission request, got %d", n)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccServerImageSharingFixtureConfig(`"stage@example.com", "prod@example.com"`) + `
+data "ncloud_server_image_sharing" "test" {
+	server_image_number    = "7654321"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "share_status", "SHARE"),
+					resource.TestCheckResourceAttr(dataName, "target_login_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataName, "target_login_ids.*", "stage@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerImageSharingFixtureConfig(loginIds string) string {
+	return fmt.Sprintf(`
+resource "ncloud_server_image_sharing" "test" {
+	server_image_number    = "7654321"
+	target_login_ids       = [%s]
+}
+`, loginIds)
+}
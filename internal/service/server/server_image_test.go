@@ -0,0 +1,139 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudServerImage_basic(t *testing.T) {
+	var serverImage vserver.ServerImage
+	name := fmt.Sprintf("tf-image-%s", sdkacctest.RandString(5))
+	resourceName := "ncloud_server_image.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudServerImageConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerImageExists(resourceName, &serverImage),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "golden image"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.server", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_image_number", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server_instance_no"},
+			},
+		},
+	})
+}
+
+func TestResourceNcloudServerImage_fixture_basic(t *testing.T) {
+	gw := acctest.NewFakeAPIGateway(t, "server_image_basic")
+	resourceName := "ncloud_server_image.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if n := gw.Requests("/vserver/v2/deleteServerImage"); n != 1 {
+				return fmt.Errorf("expected 1 deleteServerImage request, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "ncloud_server_image" "test" {
+	server_instance_no = "1234567"
+	name               = "tf-fixture-image"
+	description        = "golden image"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "7654321"),
+					resource.TestCheckResourceAttr(resourceName, "server_image_number", "7654321"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SELF"),
+					resource.TestCheckResourceAttr(resourceName, "hypervisor_type", "KVM"),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "LINUX"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudServerImageConfig(name string) string {
+	return testAccServerVpcConfig(name, "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002") + fmt.Sprintf(`
+resource "ncloud_server_image" "test" {
+	server_instance_no = ncloud_server.server.id
+	name               = "%[1]s"
+	description        = "golden image"
+}
+`, name)
+}
+
+func testAccCheckServerImageExists(n string, serverImage *vserver.ServerImage) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no server image id is set")
+		}
+
+		config := acctest.TestAccProvider.Meta().(*conn.ProviderConfig)
+		instance, err := server.GetServerImage(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if instance == nil {
+			return fmt.Errorf("server image not found: %s", rs.Primary.ID)
+		}
+
+		*serverImage = *instance
+
+		return nil
+	}
+}
+
+func testAccCheckServerImageDestroy(s *terraform.State) error {
+	config := acctest.TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_server_image" {
+			continue
+		}
+
+		instance, err := server.GetServerImage(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if instance != nil && *instance.ServerImageStatus.Code != server.ServerImageStatusCodeTerminate {
+			return errors.New("server image still exists")
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package server_test
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -190,6 +191,190 @@ func TestAccResourceNcloudServer_vpc_changeSpec(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudServer_vpc_desiredState(t *testing.T) {
+	var before serverservice.ServerInstance
+	var after serverservice.ServerInstance
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_server.server"
+	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigDesiredState(testServerName, productCode, "stopped"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "stopped"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigDesiredState(testServerName, productCode, "running"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "running"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_vpc_attachNetworkInterface(t *testing.T) {
+	var before serverservice.ServerInstance
+	var after serverservice.ServerInstance
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_server.server"
+	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigAttachNetworkInterface(testServerName, productCode, false),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "1"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigAttachNetworkInterface(testServerName, productCode, true),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface.1.network_interface_no", "ncloud_network_interface.eth1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "running"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigAttachNetworkInterface(testServerName, productCode, false),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "1"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_vpc_baseBlockStorageSize(t *testing.T) {
+	var before serverservice.ServerInstance
+	var after serverservice.ServerInstance
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_server.server"
+	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigBaseBlockStorageSize(testServerName, productCode, 100*common.GIGABYTE),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "base_block_storage_size", strconv.Itoa(100*common.GIGABYTE)),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigBaseBlockStorageSize(testServerName, productCode, 150*common.GIGABYTE),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "base_block_storage_size", strconv.Itoa(150*common.GIGABYTE)),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+			{
+				Config:      testAccServerVpcConfigBaseBlockStorageSize(testServerName, productCode, 100*common.GIGABYTE),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("base_block_storage_size is only expandable"),
+			},
+		},
+	})
+}
+
+func TestResourceNcloudServer_fixture_specValidation(t *testing.T) {
+	gw := NewFakeAPIGateway(t, "server_spec_validation")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "ncloud_server" "valid" {
+	subnet_no           = "1234"
+	server_image_number = "23214590"
+	server_spec_code    = "s2-g3"
+}
+
+resource "ncloud_server" "invalid" {
+	subnet_no           = "1234"
+	server_image_number = "23214590"
+	server_spec_code    = "s2-g2-s50"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`server_spec_code "s2-g2-s50" is not available for server_image_number\s+"23214590". Valid server specs: c2-g3, s2-g3, hm2-g3`),
+			},
+		},
+	})
+
+	// Spec list of the same image is looked up once per provider configuration.
+	if n := gw.Requests("/vserver/v2/getServerSpecList"); n != 1 {
+		t.Errorf("expected 1 getServerSpecList request, got %d", n)
+	}
+}
+
+func TestResourceNcloudServer_fixture_lifecycle(t *testing.T) {
+	gw := NewFakeAPIGateway(t, "server_lifecycle")
+	resourceName := "ncloud_server.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			for path, want := range map[string]int{
+				"/vserver/v2/createServerInstances":    1,
+				"/vserver/v2/stopServerInstances":      1,
+				"/vserver/v2/terminateServerInstances": 1,
+			} {
+				if n := gw.Requests(path); n != want {
+					return fmt.Errorf("expected %d %s requests, got %d", want, path, n)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerFixtureConfig("running"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "2000001"),
+					resource.TestCheckResourceAttr(resourceName, "instance_no", "2000001"),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "running"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.0.private_ip", "10.0.1.6"),
+				),
+			},
+			{
+				Config: testAccServerFixtureConfig("stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "2000001"),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "stopped"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerFixtureConfig(desiredState string) string {
+	return fmt.Sprintf(`
+resource "ncloud_server" "test" {
+	name                = "tf-fixture-server"
+	subnet_no           = "1234"
+	server_image_number = "23214590"
+	server_spec_code    = "s2-g3"
+	desired_state       = "%s"
+}
+`, desiredState)
+}
+
 func TestConvertToMap(t *testing.T) {
 	i := &serverservice.ServerInstance{
 		ZoneNo:                     ncloud.String("KR-1"),
@@ -375,6 +560,137 @@ resource "ncloud_server" "server" {
 `, testServerName, productCode)
 }
 
+func testAccServerVpcConfigDesiredState(testServerName, productCode, desiredState string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "%[2]s"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	desired_state = "%[3]s"
+}
+`, testServerName, productCode, desiredState)
+}
+
+func testAccServerVpcConfigAttachNetworkInterface(testServerName, productCode string, attachEth1 bool) string {
+	eth1 := ""
+	if attachEth1 {
+		eth1 = `
+	network_interface {
+		order = 1
+		network_interface_no = ncloud_network_interface.eth1.id
+	}`
+	}
+
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "public_subnet" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s-pub"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_subnet" "private_subnet" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s-priv"
+	subnet             = "10.5.1.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_network_interface" "eth0" {
+	name                  = "%[1]s-eth-0"
+	subnet_no             = ncloud_subnet.public_subnet.id
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+}
+
+resource "ncloud_network_interface" "eth1" {
+	name                  = "%[1]s-eth-1"
+	subnet_no             = ncloud_subnet.private_subnet.id
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.public_subnet.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "%[2]s"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	network_interface {
+		order = 0
+		network_interface_no = ncloud_network_interface.eth0.id
+	}
+%[3]s
+}
+`, testServerName, productCode, eth1)
+}
+
+func testAccServerVpcConfigBaseBlockStorageSize(testServerName, productCode string, size int) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "%[2]s"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	base_block_storage_size = %[3]d
+}
+`, testServerName, productCode, size)
+}
+
 func testAccServerVpcConfigNetworkInterface(testServerName, productCode string) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// validateServerSpecDiff rejects server spec or product unavailable for the server image in the zone at plan time,
+// instead of failing after minutes of apply.
+func validateServerSpecDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("server_spec_code", "server_product_code", "server_image_number", "server_image_product_code", "member_server_image_no", "zone") {
+		return nil
+	}
+
+	config := meta.(*conn.ProviderConfig)
+	zone := knownStringOrEmpty(d, "zone")
+
+	if specCode := knownStringOrEmpty(d, "server_spec_code"); specCode != "" {
+		if imageNo := knownStringOrEmpty(d, "server_image_number"); imageNo != "" {
+			return ValidateServerSpecCode(config, imageNo, zone, specCode)
+		}
+	}
+
+	if productCode := knownStringOrEmpty(d, "server_product_code"); productCode != "" {
+		imageProductCode := knownStringOrEmpty(d, "server_image_product_code")
+		memberServerImageNo := knownStringOrEmpty(d, "member_server_image_no")
+		if imageProductCode != "" || memberServerImageNo != "" {
+			return ValidateServerProductCode(config, imageProductCode, memberServerImageNo, zone, productCode)
+		}
+	}
+
+	return nil
+}
+
+func knownStringOrEmpty(d *schema.ResourceDiff, key string) string {
+	if !d.NewValueKnown(key) {
+		return ""
+	}
+
+	v, _ := d.Get(key).(string)
+	return v
+}
+
+// ValidateServerSpecCode checks the server spec is available for the server image number, same as ncloud_server_specs.
+func ValidateServerSpecCode(config *conn.ProviderConfig, imageNo, zone, specCode string) error {
+	specCodes, err := getServerSpecCodeList(config, imageNo, zone)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(specCodes, specCode) {
+		return nil
+	}
+
+	return fmt.Errorf("server_spec_code %q is not available for server_image_number %q%s. Valid server specs: %s",
+		specCode, imageNo, zoneSuffix(zone), joinOrNone(specCodes))
+}
+
+// ValidateServerProductCode checks the server product is available for the server image product or member server image,
+// same as ncloud_server_products.
+func ValidateServerProductCode(config *conn.ProviderConfig, imageProductCode, memberServerImageNo, zone, productCode string) error {
+	productCodes, err := getServerProductCodeList(config, imageProductCode, memberServerImageNo, zone)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(productCodes, productCode) {
+		return nil
+	}
+
+	image := imageProductCode
+	if image == "" {
+		image = memberServerImageNo
+	}
+
+	return fmt.Errorf("server_product_code %q is not available for server image %q%s. Valid server products: %s",
+		productCode, image, zoneSuffix(zone), joinOrNone(productCodes))
+}
+
+func getServerSpecCodeList(config *conn.ProviderConfig, imageNo, zone string) ([]string, error) {
+	return loadServerProductCache(config, fmt.Sprintf("spec/%s/%s", imageNo, zone), func() ([]string, error) {
+		return getVpcServerSpecCodeList(config, imageNo, zone)
+	})
+}
+
+func getVpcServerSpecCodeList(config *conn.ProviderConfig, imageNo, zone string) ([]string, error) {
+	reqParams := &vserver.GetServerSpecListRequest{
+		RegionCode:    &config.RegionCode,
+		ServerImageNo: ncloud.String(imageNo),
+		ZoneCode:      StringPtrOrNil(zone, zone != ""),
+	}
+
+	LogCommonRequest("getServerSpecList", reqParams)
+	resp, err := config.Client.Vserver.V2Api.GetServerSpecList(reqParams)
+	if err != nil {
+		LogErrorResponse("getServerSpecList", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getServerSpecList", resp)
+
+	specCodes := make([]string, 0, len(resp.ServerSpecList))
+	for _, spec := range resp.ServerSpecList {
+		specCodes = append(specCodes, ncloud.StringValue(spec.ServerSpecCode))
+	}
+
+	return specCodes, nil
+}
+
+func getServerProductCodeList(config *conn.ProviderConfig, imageProductCode, memberServerImageNo, zone string) ([]string, error) {
+	return loadServerProductCache(config, fmt.Sprintf("product/%s/%s/%s", imageProductCode, memberServerImageNo, zone), func() ([]string, error) {
+		return getVpcServerProductCodeList(config, imageProductCode, memberServerImageNo, zone)
+	})
+}
+
+func getVpcServerProductCodeList(config *conn.ProviderConfig, imageProductCode, memberServerImageNo, zone string) ([]string, error) {
+	reqParams := &vserver.GetServerProductListRequest{
+		RegionCode:                  &config.RegionCode,
+		ServerImageProductCode:      StringPtrOrNil(imageProductCode, imageProductCode != ""),
+		MemberServerImageInstanceNo: StringPtrOrNil(memberServerImageNo, memberServerImageNo != ""),
+		ZoneCode:                    StringPtrOrNil(zone, zone != ""),
+	}
+
+	LogCommonRequest("getServerProductList", reqParams)
+	resp, err := config.Client.Vserver.V2Api.GetServerProductList(reqParams)
+	if err != nil {
+		LogErrorResponse("getServerProductList", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getServerProductList", resp)
+
+	productCodes := make([]string, 0, len(resp.ProductList))
+	for _, product := range resp.ProductList {
+		productCodes = append(productCodes, ncloud.StringValue(product.ProductCode))
+	}
+
+	return productCodes, nil
+}
+
+type serverProductCacheEntry struct {
+	once  sync.Once
+	codes []string
+	err   error
+}
+
+// loadServerProductCache looks up once per key even when resources are planned concurrently.
+// Failed lookup is not cached, so that it is retried by the next resource.
+func loadServerProductCache(config *conn.ProviderConfig, key string, lookup func() ([]string, error)) ([]string, error) {
+	v, _ := config.ServerProductCache.LoadOrStore(key, &serverProductCacheEntry{})
+	entry := v.(*serverProductCacheEntry)
+
+	entry.once.Do(func() {
+		entry.codes, entry.err = lookup()
+	})
+
+	if entry.err != nil {
+		config.ServerProductCache.CompareAndDelete(key, entry)
+	}
+
+	return entry.codes, entry.err
+}
+
+func zoneSuffix(zone string) string {
+	if zone == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in zone %q", zone)
+}
+
+func joinOrNone(l []string) string {
+	if len(l) == 0 {
+		return "(none)"
+	}
+	return strings.Join(l, ", ")
+}
@@ -92,6 +92,53 @@ func TestAccResourceNcloudVpc_updateName(t *testing.T) {
 	})
 }
 
+func TestResourceNcloudVpc_fixture_basic(t *testing.T) {
+	gw := acctest.NewFakeAPIGateway(t, "vpc_basic")
+	resourceName := "ncloud_vpc.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if n := gw.Requests("/vpc/v2/deleteVpc"); n != 1 {
+				return fmt.Errorf("expected 1 deleteVpc request, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudVpcConfig("tf-fixture-vpc", "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "12345"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_no", "12345"),
+					resource.TestCheckResourceAttr(resourceName, "default_network_acl_no", "23456"),
+					resource.TestCheckResourceAttr(resourceName, "default_access_control_group_no", "34567"),
+					resource.TestCheckResourceAttr(resourceName, "default_public_route_table_no", "45678"),
+					resource.TestCheckResourceAttr(resourceName, "default_private_route_table_no", "45679"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceNcloudVpc_fixture_createError(t *testing.T) {
+	acctest.NewFakeAPIGateway(t, "vpc_create_error")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceNcloudVpcConfig("tf-fixture-vpc", "10.0.0.0/16"),
+				ExpectError: regexp.MustCompile(`overlapped with another VPC`),
+			},
+		},
+	})
+}
+
 func testAccResourceNcloudVpcConfig(name, cidr string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "test" {